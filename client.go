@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +23,9 @@ const (
 	// StatusTooManyRequestsEnhanceYourCalm is a non-standard HTTP status code (420)
 	// used by Twitter and Statuspage for rate limiting
 	StatusTooManyRequestsEnhanceYourCalm = 420
+
+	// DefaultMaxRetryAfter caps how long a retry waits when the server sends a Retry-After header
+	DefaultMaxRetryAfter = time.Minute
 )
 
 // Client is the main HTTP client for interacting with the Statuspage API
@@ -33,17 +38,27 @@ type Client struct {
 	// Default retry options for all requests
 	defaultRetryOptions []retry.Option
 
-	Pages             *PagesService
-	Components        *ComponentsService
-	ComponentGroups   *ComponentGroupsService
-	Incidents         *IncidentsService
-	IncidentUpdates   *IncidentUpdatesService
-	Subscribers       *SubscribersService
-	Metrics           *MetricsService
-	PageAccessUsers   *PageAccessUsersService
-	PageAccessGroups  *PageAccessGroupsService
-	Templates         *TemplatesService
-	StatusEmbedConfig *StatusEmbedConfigService
+	// Upper bound on delays requested through Retry-After and X-RateLimit-Reset headers
+	maxRetryAfter time.Duration
+
+	// Optional limiter applied to every outgoing request
+	rateLimiter RateLimiter
+
+	Pages               *PagesService
+	Components          *ComponentsService
+	ComponentGroups     *ComponentGroupsService
+	Incidents           *IncidentsService
+	IncidentUpdates     *IncidentUpdatesService
+	IncidentSubscribers *IncidentSubscribersService
+	Postmortems         *PostmortemsService
+	Subscribers         *SubscribersService
+	Metrics             *MetricsService
+	MetricsProviders    *MetricsProvidersService
+	Organizations       *OrganizationsService
+	PageAccessUsers     *PageAccessUsersService
+	PageAccessGroups    *PageAccessGroupsService
+	Templates           *TemplatesService
+	StatusEmbedConfig   *StatusEmbedConfigService
 }
 
 // ClientOption is a functional option for configuring the Client
@@ -62,10 +77,11 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 	baseURL, _ := url.Parse("https://api.statuspage.io/v1/")
 
 	c := &Client{
-		httpClient: httpClient,
-		baseURL:    baseURL,
-		apiKey:     apiKey,
-		userAgent:  userAgent,
+		httpClient:    httpClient,
+		baseURL:       baseURL,
+		apiKey:        apiKey,
+		userAgent:     userAgent,
+		maxRetryAfter: DefaultMaxRetryAfter,
 	}
 
 	// Apply client options
@@ -78,8 +94,12 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 	c.ComponentGroups = &ComponentGroupsService{client: c}
 	c.Incidents = &IncidentsService{client: c}
 	c.IncidentUpdates = &IncidentUpdatesService{client: c}
+	c.IncidentSubscribers = &IncidentSubscribersService{client: c}
+	c.Postmortems = &PostmortemsService{client: c}
 	c.Subscribers = &SubscribersService{client: c}
 	c.Metrics = &MetricsService{client: c}
+	c.MetricsProviders = &MetricsProvidersService{client: c}
+	c.Organizations = &OrganizationsService{client: c}
 	c.PageAccessUsers = &PageAccessUsersService{client: c}
 	c.PageAccessGroups = &PageAccessGroupsService{client: c}
 	c.Templates = &TemplatesService{client: c}
@@ -131,6 +151,31 @@ func WithDefaultRetryConfig() ClientOption {
 	)
 }
 
+// WithMaxRetryAfter caps how long a retry waits when the server requests a delay through the Retry-After or
+// X-RateLimit-Reset headers. A non-positive value removes the cap.
+func WithMaxRetryAfter(max time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRetryAfter = max
+	}
+}
+
+// WithRateLimiter throttles every request made by the client through the provided limiter
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithRateLimit throttles requests to requestsPerSecond on average, allowing bursts of up to burst requests
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return WithRateLimiter(NewTokenBucketLimiter(requestsPerSecond, burst))
+}
+
+// WithDefaultRateLimit throttles requests to the one request per second quota enforced by Statuspage
+func WithDefaultRateLimit() ClientOption {
+	return WithRateLimit(DefaultRequestsPerSecond, 1)
+}
+
 // SetHTTPClient updates the underlying HTTP client used for API requests
 func (c *Client) SetHTTPClient(httpClient *http.Client) {
 	c.httpClient = httpClient
@@ -172,7 +217,9 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	}
 	req.Header.Set("Accept", "application/json")
 	//req.Header.Set("User-Agent", c.userAgent)
-	req.Header.Set("Authorization", fmt.Sprintf("OAuth %s", c.apiKey))
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("OAuth %s", c.apiKey))
+	}
 
 	return req, nil
 }
@@ -189,13 +236,19 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 // doRequest performs the actual HTTP request without retry logic
 func (c *Client) doRequest(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	response := &Response{Response: resp}
+	response := newResponse(resp)
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -222,22 +275,148 @@ func (c *Client) doRequest(ctx context.Context, req *http.Request, v interface{}
 // Response wraps the standard HTTP response with additional Statuspage-specific functionality
 type Response struct {
 	*http.Response
+
+	// Pagination values parsed from the Link and X-* headers, zero when the API did not send them
+	NextPage  int
+	PrevPage  int
+	FirstPage int
+	LastPage  int
+	PerPage   int
+	Total     int
+
+	// Rate limit values parsed from the X-RateLimit-* headers
+	Rate Rate
+
+	hasLinks bool
+}
+
+// Rate represents the rate limit state reported by the API for the current API key
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// newResponse wraps an HTTP response and parses its pagination and rate limit headers
+func newResponse(r *http.Response) *Response {
+	response := &Response{Response: r}
+	response.populatePageValues()
+	response.Rate = parseRate(r)
+	return response
+}
+
+// populatePageValues parses pagination values from the Link header and the X-Page style headers
+func (r *Response) populatePageValues() {
+	if links := r.Header.Get("Link"); links != "" {
+		for _, link := range strings.Split(links, ",") {
+			segments := strings.Split(strings.TrimSpace(link), ";")
+			if len(segments) < 2 {
+				continue
+			}
+
+			target := strings.TrimSpace(segments[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			u, err := url.Parse(target[1 : len(target)-1])
+			if err != nil {
+				continue
+			}
+			page, err := strconv.Atoi(u.Query().Get("page"))
+			if err != nil {
+				continue
+			}
+
+			for _, segment := range segments[1:] {
+				switch strings.TrimSpace(segment) {
+				case `rel="next"`:
+					r.NextPage = page
+				case `rel="prev"`:
+					r.PrevPage = page
+				case `rel="first"`:
+					r.FirstPage = page
+				case `rel="last"`:
+					r.LastPage = page
+				default:
+					continue
+				}
+				r.hasLinks = true
+			}
+		}
+	}
+
+	if r.NextPage == 0 {
+		r.NextPage = headerInt(r.Header, "X-Next-Page")
+	}
+	if r.PrevPage == 0 {
+		r.PrevPage = headerInt(r.Header, "X-Prev-Page")
+	}
+	if r.LastPage == 0 {
+		r.LastPage = headerInt(r.Header, "X-Total-Pages")
+	}
+	r.PerPage = headerInt(r.Header, "X-Per-Page")
+	r.Total = headerInt(r.Header, "X-Total")
+
+	if r.LastPage == 0 && r.Total > 0 && r.PerPage > 0 {
+		r.LastPage = (r.Total + r.PerPage - 1) / r.PerPage
+	}
+}
+
+// isLastPage reports whether the pagination headers show that page is the final page of results
+func (r *Response) isLastPage(page int) bool {
+	if r.hasLinks {
+		return r.NextPage == 0
+	}
+	return r.LastPage > 0 && page >= r.LastPage
+}
+
+// parseRate parses the X-RateLimit-* headers from an HTTP response
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	rate.Limit = headerInt(r.Header, "X-RateLimit-Limit")
+	rate.Remaining = headerInt(r.Header, "X-RateLimit-Remaining")
+	if reset := headerInt(r.Header, "X-RateLimit-Reset"); reset > 0 {
+		rate.Reset = time.Unix(int64(reset), 0)
+	}
+	return rate
+}
+
+// headerInt returns the integer value of a response header, or zero when it is missing or malformed
+func headerInt(h http.Header, key string) int {
+	v, err := strconv.Atoi(strings.TrimSpace(h.Get(key)))
+	if err != nil {
+		return 0
+	}
+	return v
 }
 
 // ErrorResponse represents an API error with HTTP response details and descriptive message
 type ErrorResponse struct {
 	Response *http.Response
-	Message  string `json:"message"`
+	Message  string          `json:"message"`
+	RawError json.RawMessage `json:"error,omitempty"`
 }
 
 // Error implements the error interface for ErrorResponse
 func (r *ErrorResponse) Error() string {
+	if r.Response.Request == nil {
+		return fmt.Sprintf("%d %v", r.Response.StatusCode, r.Message)
+	}
 	return fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method, r.Response.Request.URL,
 		r.Response.StatusCode, r.Message)
 }
 
-// CheckResponse validates an HTTP response and returns an appropriate error for non-2xx status codes
+// Is reports whether the error matches one of the sentinel errors for its HTTP status code
+func (r *ErrorResponse) Is(target error) bool {
+	sentinel := sentinelForStatus(r.Response.StatusCode)
+	return sentinel != nil && sentinel == target
+}
+
+// CheckResponse validates an HTTP response and returns an appropriate error for non-2xx status codes.
+// Validation failures are returned as *ValidationError; every error matches the sentinel for its status
+// code through errors.Is.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; http.StatusOK <= c && c <= 299 {
 		return nil
@@ -249,25 +428,35 @@ func CheckResponse(r *http.Response) error {
 		json.Unmarshal(data, errorResponse)
 	}
 
-	switch r.StatusCode {
-	case http.StatusBadRequest:
-		errorResponse.Message = "Bad request"
-	case http.StatusUnauthorized:
-		errorResponse.Message = "Could not authenticate"
-	case http.StatusForbidden:
-		errorResponse.Message = "You are not authorized to access this resource"
-	case http.StatusNotFound:
-		errorResponse.Message = "The requested resource could not be found"
-	case http.StatusUnprocessableEntity:
-		errorResponse.Message = "Unprocessable entity"
-	case StatusTooManyRequestsEnhanceYourCalm, http.StatusTooManyRequests:
-		errorResponse.Message = "Rate limit exceeded"
-	default:
-		if errorResponse.Message == "" {
+	fields := parseErrorFields(errorResponse.RawError)
+	if errorResponse.Message == "" {
+		errorResponse.Message = joinFieldErrors(fields)
+	}
+
+	if errorResponse.Message == "" {
+		switch r.StatusCode {
+		case http.StatusBadRequest:
+			errorResponse.Message = "Bad request"
+		case http.StatusUnauthorized:
+			errorResponse.Message = "Could not authenticate"
+		case http.StatusForbidden:
+			errorResponse.Message = "You are not authorized to access this resource"
+		case http.StatusNotFound:
+			errorResponse.Message = "The requested resource could not be found"
+		case http.StatusUnprocessableEntity:
+			errorResponse.Message = "Unprocessable entity"
+		case StatusTooManyRequestsEnhanceYourCalm, http.StatusTooManyRequests:
+			errorResponse.Message = "Rate limit exceeded"
+		default:
 			errorResponse.Message = r.Status
 		}
 	}
 
+	switch r.StatusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return &ValidationError{ErrorResponse: errorResponse, Fields: fields}
+	}
+
 	return errorResponse
 }
 
@@ -291,7 +480,7 @@ func addOptions(s string, opts interface{}) (string, error) {
 // query converts various option structs to URL query parameters using reflection
 func query(opts interface{}) (url.Values, error) {
 	v := url.Values{}
-	if opts == nil {
+	if rv := reflect.ValueOf(opts); opts == nil || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
 		return v, nil
 	}
 
@@ -325,6 +514,21 @@ func query(opts interface{}) (url.Values, error) {
 		if o.Q != "" {
 			v.Set("q", o.Q)
 		}
+		if o.Type != "" {
+			v.Set("type", o.Type)
+		}
+		if o.State != "" {
+			v.Set("state", o.State)
+		}
+		if o.Limit > 0 {
+			v.Set("limit", fmt.Sprintf("%d", o.Limit))
+		}
+		if o.SortField != "" {
+			v.Set("sort_field", o.SortField)
+		}
+		if o.SortDirection != "" {
+			v.Set("sort_direction", o.SortDirection)
+		}
 		if o.Sort != "" {
 			v.Set("sort", o.Sort)
 		}
@@ -334,6 +538,23 @@ func query(opts interface{}) (url.Values, error) {
 		if o.PerPage > 0 {
 			v.Set("per_page", fmt.Sprintf("%d", o.PerPage))
 		}
+	case *SubscriberCountOptions:
+		if o.Type != "" {
+			v.Set("type", o.Type)
+		}
+		if o.State != "" {
+			v.Set("state", o.State)
+		}
+	case *UptimeOptions:
+		if o.Start != "" {
+			v.Set("start", o.Start)
+		}
+		if o.End != "" {
+			v.Set("end", o.End)
+		}
+		if o.SkipRelatedEvents {
+			v.Set("skip_related_events", "true")
+		}
 	case *MetricDataListOptions:
 		if o.From != nil {
 			v.Set("from", o.From.Format(time.RFC3339))
@@ -15,76 +15,113 @@ type ComponentGroupRequest struct {
 }
 
 type ComponentGroupInput struct {
-	Name        string   `json:"name,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Components  []string `json:"components,omitempty"`
-	Position    int      `json:"position,omitempty"`
+	Name        string           `json:"name,omitempty" replace:"omitempty"`
+	Description Nullable[string] `json:"description,omitempty"`
+	Components  []string         `json:"components,omitempty" replace:"omitempty"`
+	Position    Nullable[int]    `json:"position,omitempty"`
 }
 
-func (s *ComponentGroupsService) List(ctx context.Context, pageID string) ([]*ComponentGroup, error) {
+func (s *ComponentGroupsService) List(ctx context.Context, pageID string, opts *ListOptions) ([]*ComponentGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/component-groups", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var groups []*ComponentGroup
-	_, err = s.client.Do(ctx, req, &groups)
+	resp, err := s.client.Do(ctx, req, &groups)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return groups, nil
+	return groups, resp, nil
+}
+
+func (s *ComponentGroupsService) ListAll(ctx context.Context, pageID string, opts *ListOptions) ([]*ComponentGroup, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*ComponentGroup, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
+}
+
+func (s *ComponentGroupsService) Walk(ctx context.Context, pageID string, opts *ListOptions, fn func(*ComponentGroup) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*ComponentGroup, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
 }
 
-func (s *ComponentGroupsService) Get(ctx context.Context, pageID, groupID string) (*ComponentGroup, error) {
+func (s *ComponentGroupsService) Get(ctx context.Context, pageID, groupID string) (*ComponentGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/component-groups/%s", pageID, groupID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	group := new(ComponentGroup)
-	_, err = s.client.Do(ctx, req, group)
+	resp, err := s.client.Do(ctx, req, group)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return group, nil
+	return group, resp, nil
 }
 
-func (s *ComponentGroupsService) Create(ctx context.Context, pageID string, group *ComponentGroupInput) (*ComponentGroup, error) {
+func (s *ComponentGroupsService) Create(ctx context.Context, pageID string, group *ComponentGroupInput) (*ComponentGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/component-groups", pageID)
 	groupReq := &ComponentGroupRequest{ComponentGroup: group}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, groupReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newGroup := new(ComponentGroup)
-	_, err = s.client.Do(ctx, req, newGroup)
+	resp, err := s.client.Do(ctx, req, newGroup)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newGroup, nil
+	return newGroup, resp, nil
 }
 
-func (s *ComponentGroupsService) Update(ctx context.Context, pageID, groupID string, group *ComponentGroupInput) (*ComponentGroup, error) {
+func (s *ComponentGroupsService) Update(ctx context.Context, pageID, groupID string, group *ComponentGroupInput) (*ComponentGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/component-groups/%s", pageID, groupID)
 	groupReq := &ComponentGroupRequest{ComponentGroup: group}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, groupReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedGroup := new(ComponentGroup)
-	_, err = s.client.Do(ctx, req, updatedGroup)
+	resp, err := s.client.Do(ctx, req, updatedGroup)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
+	}
+
+	return updatedGroup, resp, nil
+}
+
+func (s *ComponentGroupsService) Replace(ctx context.Context, pageID, groupID string, group *ComponentGroupInput) (*ComponentGroup, *Response, error) {
+	u := fmt.Sprintf("pages/%s/component-groups/%s", pageID, groupID)
+	groupReq, err := replaceRequest("component_group", group)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, groupReq)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return updatedGroup, nil
+	updatedGroup := new(ComponentGroup)
+	resp, err := s.client.Do(ctx, req, updatedGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedGroup, resp, nil
 }
 
 func (s *ComponentGroupsService) Delete(ctx context.Context, pageID, groupID string) (*Response, error) {
@@ -101,3 +138,44 @@ func (s *ComponentGroupsService) Delete(ctx context.Context, pageID, groupID str
 
 	return resp, nil
 }
+
+func (s *ComponentGroupsService) Uptime(ctx context.Context, pageID, groupID string, opts *UptimeOptions) (*ComponentGroupUptime, *Response, error) {
+	u := fmt.Sprintf("pages/%s/component-groups/%s/uptime", pageID, groupID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uptime := new(ComponentGroupUptime)
+	resp, err := s.client.Do(ctx, req, uptime)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return uptime, resp, nil
+}
+
+func (s *ComponentGroupsService) UptimeByDay(ctx context.Context, pageID, groupID string, opts *UptimeOptions) ([]*ComponentGroupUptime, *Response, error) {
+	days, err := uptimeDays(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp *Response
+	uptimes := make([]*ComponentGroupUptime, 0, len(days))
+	for _, day := range days {
+		var uptime *ComponentGroupUptime
+		uptime, resp, err = s.Uptime(ctx, pageID, groupID, day)
+		if err != nil {
+			return nil, resp, err
+		}
+		uptimes = append(uptimes, uptime)
+	}
+
+	return uptimes, resp, nil
+}
@@ -18,13 +18,23 @@ type ComponentRequest struct {
 
 // ComponentInput contains the editable fields for creating or updating a component
 type ComponentInput struct {
-	Name               string     `json:"name,omitempty"`
-	Description        string     `json:"description,omitempty"`
-	Status             string     `json:"status,omitempty"`
-	OnlyShowIfDegraded *bool      `json:"only_show_if_degraded,omitempty"`
-	GroupID            string     `json:"group_id,omitempty"`
-	Showcase           *bool      `json:"showcase,omitempty"`
-	StartDate          *Time `json:"start_date,omitempty"`
+	Name               string           `json:"name,omitempty" replace:"omitempty"`
+	Description        Nullable[string] `json:"description,omitempty"`
+	Status             string           `json:"status,omitempty" replace:"omitempty"`
+	OnlyShowIfDegraded Nullable[bool]   `json:"only_show_if_degraded,omitempty"`
+	GroupID            Nullable[string] `json:"group_id,omitempty"`
+	Showcase           Nullable[bool]   `json:"showcase,omitempty"`
+	StartDate          Nullable[Time]   `json:"start_date,omitempty"`
+}
+
+// ComponentPageAccessUsersRequest lists the page access users to grant access to a component
+type ComponentPageAccessUsersRequest struct {
+	PageAccessUserIDs []string `json:"page_access_user_ids"`
+}
+
+// ComponentPageAccessGroupsRequest lists the page access groups to grant access to a component
+type ComponentPageAccessGroupsRequest struct {
+	PageAccessGroupIDs []string `json:"page_access_group_ids"`
 }
 
 // ComponentStatusInput is used specifically for updating only the status of a component
@@ -43,74 +53,114 @@ const (
 	ComponentStatusUnderMaintenance    = "under_maintenance"
 )
 
-// List retrieves all components for a specific status page
-func (s *ComponentsService) List(ctx context.Context, pageID string) ([]*Component, error) {
+// List retrieves a single page of components for a specific status page
+func (s *ComponentsService) List(ctx context.Context, pageID string, opts *ListOptions) ([]*Component, *Response, error) {
 	u := fmt.Sprintf("pages/%s/components", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var components []*Component
-	_, err = s.client.Do(ctx, req, &components)
+	resp, err := s.client.Do(ctx, req, &components)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return components, nil
+	return components, resp, nil
+}
+
+// ListAll retrieves every component for a status page by walking through all result pages
+func (s *ComponentsService) ListAll(ctx context.Context, pageID string, opts *ListOptions) ([]*Component, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Component, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
+}
+
+// Walk calls fn for every component on a status page, requesting further pages until an empty page is returned
+func (s *ComponentsService) Walk(ctx context.Context, pageID string, opts *ListOptions, fn func(*Component) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*Component, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
 }
 
 // Get retrieves a specific component by its unique identifier
-func (s *ComponentsService) Get(ctx context.Context, pageID, componentID string) (*Component, error) {
+func (s *ComponentsService) Get(ctx context.Context, pageID, componentID string) (*Component, *Response, error) {
 	u := fmt.Sprintf("pages/%s/components/%s", pageID, componentID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	component := new(Component)
-	_, err = s.client.Do(ctx, req, component)
+	resp, err := s.client.Do(ctx, req, component)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return component, nil
+	return component, resp, nil
 }
 
 // Create adds a new component to track on the status page
-func (s *ComponentsService) Create(ctx context.Context, pageID string, component *ComponentInput) (*Component, error) {
+func (s *ComponentsService) Create(ctx context.Context, pageID string, component *ComponentInput) (*Component, *Response, error) {
 	u := fmt.Sprintf("pages/%s/components", pageID)
 	componentReq := &ComponentRequest{Component: component}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, componentReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newComponent := new(Component)
-	_, err = s.client.Do(ctx, req, newComponent)
+	resp, err := s.client.Do(ctx, req, newComponent)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newComponent, nil
+	return newComponent, resp, nil
 }
 
 // Update modifies an existing component's configuration
-func (s *ComponentsService) Update(ctx context.Context, pageID, componentID string, component *ComponentInput) (*Component, error) {
+func (s *ComponentsService) Update(ctx context.Context, pageID, componentID string, component *ComponentInput) (*Component, *Response, error) {
 	u := fmt.Sprintf("pages/%s/components/%s", pageID, componentID)
 	componentReq := &ComponentRequest{Component: component}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, componentReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedComponent := new(Component)
-	_, err = s.client.Do(ctx, req, updatedComponent)
+	resp, err := s.client.Do(ctx, req, updatedComponent)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updatedComponent, nil
+	return updatedComponent, resp, nil
+}
+
+// Replace overwrites every editable field of a component, clearing those left unset in the input
+func (s *ComponentsService) Replace(ctx context.Context, pageID, componentID string, component *ComponentInput) (*Component, *Response, error) {
+	u := fmt.Sprintf("pages/%s/components/%s", pageID, componentID)
+	componentReq, err := replaceRequest("component", component)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, componentReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedComponent := new(Component)
+	resp, err := s.client.Do(ctx, req, updatedComponent)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedComponent, resp, nil
 }
 
 // Delete removes a component from the status page
@@ -130,21 +180,105 @@ func (s *ComponentsService) Delete(ctx context.Context, pageID, componentID stri
 }
 
 // UpdateStatus changes only the operational status of a component
-func (s *ComponentsService) UpdateStatus(ctx context.Context, pageID, componentID, status string) (*Component, error) {
+func (s *ComponentsService) UpdateStatus(ctx context.Context, pageID, componentID, status string) (*Component, *Response, error) {
 	u := fmt.Sprintf("pages/%s/components/%s", pageID, componentID)
 	statusInput := &ComponentStatusInput{}
 	statusInput.Component.Status = status
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, statusInput)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	component := new(Component)
-	_, err = s.client.Do(ctx, req, component)
+	resp, err := s.client.Do(ctx, req, component)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
+	}
+
+	return component, resp, nil
+}
+
+// Uptime retrieves uptime statistics for a component that has the uptime showcase enabled
+func (s *ComponentsService) Uptime(ctx context.Context, pageID, componentID string, opts *UptimeOptions) (*ComponentUptime, *Response, error) {
+	u := fmt.Sprintf("pages/%s/components/%s/uptime", pageID, componentID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uptime := new(ComponentUptime)
+	resp, err := s.client.Do(ctx, req, uptime)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return uptime, resp, nil
+}
+
+// UptimeByDay retrieves one uptime record per calendar day between opts.Start and opts.End.
+// The API only reports totals for a range, so this issues one request per day.
+func (s *ComponentsService) UptimeByDay(ctx context.Context, pageID, componentID string, opts *UptimeOptions) ([]*ComponentUptime, *Response, error) {
+	days, err := uptimeDays(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp *Response
+	uptimes := make([]*ComponentUptime, 0, len(days))
+	for _, day := range days {
+		var uptime *ComponentUptime
+		uptime, resp, err = s.Uptime(ctx, pageID, componentID, day)
+		if err != nil {
+			return nil, resp, err
+		}
+		uptimes = append(uptimes, uptime)
+	}
+
+	return uptimes, resp, nil
+}
+
+// AddPageAccessUsers grants page access users access to a component
+func (s *ComponentsService) AddPageAccessUsers(ctx context.Context, pageID, componentID string, userIDs []string) (*Component, *Response, error) {
+	u := fmt.Sprintf("pages/%s/components/%s/page_access_users", pageID, componentID)
+	return s.updatePageAccess(ctx, http.MethodPost, u, &ComponentPageAccessUsersRequest{PageAccessUserIDs: userIDs})
+}
+
+// RemovePageAccessUsers revokes every page access user's access to a component
+func (s *ComponentsService) RemovePageAccessUsers(ctx context.Context, pageID, componentID string) (*Component, *Response, error) {
+	u := fmt.Sprintf("pages/%s/components/%s/page_access_users", pageID, componentID)
+	return s.updatePageAccess(ctx, http.MethodDelete, u, nil)
+}
+
+// AddPageAccessGroups grants page access groups access to a component
+func (s *ComponentsService) AddPageAccessGroups(ctx context.Context, pageID, componentID string, groupIDs []string) (*Component, *Response, error) {
+	u := fmt.Sprintf("pages/%s/components/%s/page_access_groups", pageID, componentID)
+	return s.updatePageAccess(ctx, http.MethodPost, u, &ComponentPageAccessGroupsRequest{PageAccessGroupIDs: groupIDs})
+}
+
+// RemovePageAccessGroups revokes every page access group's access to a component
+func (s *ComponentsService) RemovePageAccessGroups(ctx context.Context, pageID, componentID string) (*Component, *Response, error) {
+	u := fmt.Sprintf("pages/%s/components/%s/page_access_groups", pageID, componentID)
+	return s.updatePageAccess(ctx, http.MethodDelete, u, nil)
+}
+
+// updatePageAccess sends a page access change for a component and decodes the updated component
+func (s *ComponentsService) updatePageAccess(ctx context.Context, method, u string, body interface{}) (*Component, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(Component)
+	resp, err := s.client.Do(ctx, req, component)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return component, nil
+	return component, resp, nil
 }
@@ -0,0 +1,116 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors matched by API errors through errors.Is
+var (
+	ErrUnauthorized = errors.New("statuspage: could not authenticate")
+	ErrForbidden    = errors.New("statuspage: not authorized to access this resource")
+	ErrNotFound     = errors.New("statuspage: resource not found")
+	ErrValidation   = errors.New("statuspage: request failed validation")
+	ErrRateLimited  = errors.New("statuspage: rate limit exceeded")
+)
+
+// FieldError describes a single validation failure reported by the API. Field is empty when the API
+// reported a message that is not tied to a specific attribute.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error implements the error interface for FieldError
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// ValidationError is returned for 400 and 422 responses and carries the field-level details from the error body
+type ValidationError struct {
+	*ErrorResponse
+	Fields []FieldError
+}
+
+// Unwrap returns the underlying ErrorResponse so errors.As can match either type
+func (e *ValidationError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// sentinelForStatus maps an HTTP status code to the sentinel error it matches
+func sentinelForStatus(code int) error {
+	switch code {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	case StatusTooManyRequestsEnhanceYourCalm, http.StatusTooManyRequests:
+		return ErrRateLimited
+	default:
+		return nil
+	}
+}
+
+// parseErrorFields decodes the Statuspage "error" attribute, which is either a single message, a list of
+// messages, or an object mapping attribute names to one or more messages
+func parseErrorFields(raw json.RawMessage) []FieldError {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		if message == "" {
+			return nil
+		}
+		return []FieldError{{Message: message}}
+	}
+
+	var messages []string
+	if err := json.Unmarshal(raw, &messages); err == nil {
+		fields := make([]FieldError, 0, len(messages))
+		for _, m := range messages {
+			fields = append(fields, FieldError{Message: m})
+		}
+		return fields
+	}
+
+	var byField map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &byField); err == nil {
+		names := make([]string, 0, len(byField))
+		for name := range byField {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var fields []FieldError
+		for _, name := range names {
+			for _, f := range parseErrorFields(byField[name]) {
+				fields = append(fields, FieldError{Field: name, Message: f.Message})
+			}
+		}
+		return fields
+	}
+
+	return nil
+}
+
+// joinFieldErrors renders field errors as a single human readable message
+func joinFieldErrors(fields []FieldError) string {
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		parts = append(parts, f.Error())
+	}
+	return strings.Join(parts, "; ")
+}
@@ -2,4 +2,7 @@ module github.com/MinseokOh/statuspage-sdk-go
 
 go 1.19
 
-require github.com/avast/retry-go/v4 v4.6.1
+require (
+	github.com/avast/retry-go/v4 v4.6.1
+	gopkg.in/yaml.v3 v3.0.1
+)
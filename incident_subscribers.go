@@ -0,0 +1,128 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// IncidentSubscribersService handles subscribers that only receive notifications about a single incident
+type IncidentSubscribersService struct {
+	client *Client
+}
+
+// IncidentSubscriberRequest wraps incident subscriber input data for API requests
+type IncidentSubscriberRequest struct {
+	Subscriber *IncidentSubscriberInput `json:"subscriber"`
+}
+
+// IncidentSubscriberInput contains the fields for subscribing an email address or phone number to an incident
+type IncidentSubscriberInput struct {
+	Email                        string         `json:"email,omitempty"`
+	PhoneCountry                 string         `json:"phone_country,omitempty"`
+	PhoneNumber                  string         `json:"phone_number,omitempty"`
+	SkipConfirmationNotification Nullable[bool] `json:"skip_confirmation_notification,omitempty"`
+}
+
+// List retrieves a single page of subscribers for an incident
+func (s *IncidentSubscribersService) List(ctx context.Context, pageID, incidentID string, opts *ListOptions) ([]*Subscriber, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/subscribers", pageID, incidentID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscribers []*Subscriber
+	resp, err := s.client.Do(ctx, req, &subscribers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscribers, resp, nil
+}
+
+// ListAll retrieves every subscriber for an incident by walking through all result pages
+func (s *IncidentSubscribersService) ListAll(ctx context.Context, pageID, incidentID string, opts *ListOptions) ([]*Subscriber, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Subscriber, *Response, error) {
+		return s.List(ctx, pageID, incidentID, opts.forPage(page))
+	})
+}
+
+// Walk calls fn for every subscriber of an incident, fetching result pages as needed
+func (s *IncidentSubscribersService) Walk(ctx context.Context, pageID, incidentID string, opts *ListOptions, fn func(*Subscriber) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*Subscriber, *Response, error) {
+		return s.List(ctx, pageID, incidentID, opts.forPage(page))
+	}, fn)
+}
+
+// Get retrieves a single subscriber of an incident
+func (s *IncidentSubscribersService) Get(ctx context.Context, pageID, incidentID, subscriberID string) (*Subscriber, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/subscribers/%s", pageID, incidentID, subscriberID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subscriber := new(Subscriber)
+	resp, err := s.client.Do(ctx, req, subscriber)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscriber, resp, nil
+}
+
+// Create subscribes an email address or phone number to an incident
+func (s *IncidentSubscribersService) Create(ctx context.Context, pageID, incidentID string, subscriber *IncidentSubscriberInput) (*Subscriber, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/subscribers", pageID, incidentID)
+	subscriberReq := &IncidentSubscriberRequest{Subscriber: subscriber}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, subscriberReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newSubscriber := new(Subscriber)
+	resp, err := s.client.Do(ctx, req, newSubscriber)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newSubscriber, resp, nil
+}
+
+// Delete unsubscribes a subscriber from an incident
+func (s *IncidentSubscribersService) Delete(ctx context.Context, pageID, incidentID, subscriberID string) (*Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/subscribers/%s", pageID, incidentID, subscriberID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// ResendConfirmation sends the confirmation notification to an unconfirmed incident subscriber again
+func (s *IncidentSubscribersService) ResendConfirmation(ctx context.Context, pageID, incidentID, subscriberID string) (*Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/subscribers/%s/resend_confirmation", pageID, incidentID, subscriberID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
@@ -15,77 +15,97 @@ type IncidentUpdateRequest struct {
 }
 
 type IncidentUpdateInput struct {
-	Body                 string            `json:"body,omitempty"`
-	Status               string            `json:"status,omitempty"`
-	DeliverNotifications *bool             `json:"deliver_notifications,omitempty"`
-	CustomTweet          string            `json:"custom_tweet,omitempty"`
-	TweetID              string            `json:"tweet_id,omitempty"`
-	Components           map[string]string `json:"components,omitempty"`
-	AffectedComponents   []string          `json:"affected_components,omitempty"`
+	Body                 string            `json:"body,omitempty" replace:"omitempty"`
+	Status               string            `json:"status,omitempty" replace:"omitempty"`
+	DeliverNotifications Nullable[bool]    `json:"deliver_notifications,omitempty" replace:"omitempty"`
+	CustomTweet          Nullable[string]  `json:"custom_tweet,omitempty"`
+	TweetID              Nullable[string]  `json:"tweet_id,omitempty"`
+	Components           map[string]string `json:"components,omitempty" replace:"omitempty"`
+	AffectedComponents   []string          `json:"affected_components,omitempty" replace:"omitempty"`
 }
 
-func (s *IncidentUpdatesService) List(ctx context.Context, pageID, incidentID string) ([]*IncidentUpdate, error) {
+func (s *IncidentUpdatesService) List(ctx context.Context, pageID, incidentID string) ([]*IncidentUpdate, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents/%s/incident_updates", pageID, incidentID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var updates []*IncidentUpdate
-	_, err = s.client.Do(ctx, req, &updates)
+	resp, err := s.client.Do(ctx, req, &updates)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updates, nil
+	return updates, resp, nil
 }
 
-func (s *IncidentUpdatesService) Get(ctx context.Context, pageID, incidentID, updateID string) (*IncidentUpdate, error) {
+func (s *IncidentUpdatesService) Get(ctx context.Context, pageID, incidentID, updateID string) (*IncidentUpdate, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents/%s/incident_updates/%s", pageID, incidentID, updateID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	update := new(IncidentUpdate)
-	_, err = s.client.Do(ctx, req, update)
+	resp, err := s.client.Do(ctx, req, update)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return update, nil
+	return update, resp, nil
 }
 
-func (s *IncidentUpdatesService) Create(ctx context.Context, pageID, incidentID string, update *IncidentUpdateInput) (*IncidentUpdate, error) {
+func (s *IncidentUpdatesService) Create(ctx context.Context, pageID, incidentID string, update *IncidentUpdateInput) (*IncidentUpdate, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents/%s/incident_updates", pageID, incidentID)
 	updateReq := &IncidentUpdateRequest{IncidentUpdate: update}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, updateReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newUpdate := new(IncidentUpdate)
-	_, err = s.client.Do(ctx, req, newUpdate)
+	resp, err := s.client.Do(ctx, req, newUpdate)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newUpdate, nil
+	return newUpdate, resp, nil
 }
 
-func (s *IncidentUpdatesService) Update(ctx context.Context, pageID, incidentID, updateID string, update *IncidentUpdateInput) (*IncidentUpdate, error) {
+func (s *IncidentUpdatesService) Update(ctx context.Context, pageID, incidentID, updateID string, update *IncidentUpdateInput) (*IncidentUpdate, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents/%s/incident_updates/%s", pageID, incidentID, updateID)
 	updateReq := &IncidentUpdateRequest{IncidentUpdate: update}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, updateReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedUpdate := new(IncidentUpdate)
-	_, err = s.client.Do(ctx, req, updatedUpdate)
+	resp, err := s.client.Do(ctx, req, updatedUpdate)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updatedUpdate, nil
+	return updatedUpdate, resp, nil
+}
+
+func (s *IncidentUpdatesService) Replace(ctx context.Context, pageID, incidentID, updateID string, update *IncidentUpdateInput) (*IncidentUpdate, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/incident_updates/%s", pageID, incidentID, updateID)
+	updateReq, err := replaceRequest("incident_update", update)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, updateReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedUpdate := new(IncidentUpdate)
+	resp, err := s.client.Do(ctx, req, updatedUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedUpdate, resp, nil
 }
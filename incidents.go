@@ -19,28 +19,28 @@ type IncidentRequest struct {
 
 // IncidentInput contains the editable fields for creating or updating an incident
 type IncidentInput struct {
-	Name                                      string                 `json:"name,omitempty"`
-	Status                                    string                 `json:"status,omitempty"`
-	ImpactOverride                            string                 `json:"impact_override,omitempty"`
-	ScheduledFor                              *time.Time             `json:"scheduled_for,omitempty"`
-	ScheduledUntil                            *time.Time             `json:"scheduled_until,omitempty"`
-	ScheduledRemindPrior                      *bool                  `json:"scheduled_remind_prior,omitempty"`
-	ScheduledAutoInProgress                   *bool                  `json:"scheduled_auto_in_progress,omitempty"`
-	ScheduledAutoCompleted                    *bool                  `json:"scheduled_auto_completed,omitempty"`
-	Body                                      string                 `json:"body,omitempty"`
-	ComponentIDs                              []string               `json:"component_ids,omitempty"`
-	Components                                map[string]string      `json:"components,omitempty"`
-	DeliverNotifications                      *bool                  `json:"deliver_notifications,omitempty"`
-	AutoTransitionDeliverNotificationsAtEnd   *bool                  `json:"auto_transition_deliver_notifications_at_end,omitempty"`
-	AutoTransitionDeliverNotificationsAtStart *bool                  `json:"auto_transition_deliver_notifications_at_start,omitempty"`
-	AutoTransitionToMaintenanceState          *bool                  `json:"auto_transition_to_maintenance_state,omitempty"`
-	AutoTransitionToOperationalState          *bool                  `json:"auto_transition_to_operational_state,omitempty"`
-	AutoTweetAtBeginning                      *bool                  `json:"auto_tweet_at_beginning,omitempty"`
-	AutoTweetOnCompletion                     *bool                  `json:"auto_tweet_on_completion,omitempty"`
-	AutoTweetOnCreation                       *bool                  `json:"auto_tweet_on_creation,omitempty"`
-	AutoTweetOneHourBefore                    *bool                  `json:"auto_tweet_one_hour_before,omitempty"`
-	BackfillDate                              string                 `json:"backfill_date,omitempty"`
-	Backfilled                                *bool                  `json:"backfilled,omitempty"`
+	Name                                      string                 `json:"name,omitempty" replace:"omitempty"`
+	Status                                    string                 `json:"status,omitempty" replace:"omitempty"`
+	ImpactOverride                            Nullable[string]       `json:"impact_override,omitempty"`
+	ScheduledFor                              Nullable[time.Time]    `json:"scheduled_for,omitempty"`
+	ScheduledUntil                            Nullable[time.Time]    `json:"scheduled_until,omitempty"`
+	ScheduledRemindPrior                      Nullable[bool]         `json:"scheduled_remind_prior,omitempty"`
+	ScheduledAutoInProgress                   Nullable[bool]         `json:"scheduled_auto_in_progress,omitempty"`
+	ScheduledAutoCompleted                    Nullable[bool]         `json:"scheduled_auto_completed,omitempty"`
+	Body                                      string                 `json:"body,omitempty" replace:"omitempty"`
+	ComponentIDs                              []string               `json:"component_ids,omitempty" replace:"omitempty"`
+	Components                                map[string]string      `json:"components,omitempty" replace:"omitempty"`
+	DeliverNotifications                      Nullable[bool]         `json:"deliver_notifications,omitempty" replace:"omitempty"`
+	AutoTransitionDeliverNotificationsAtEnd   Nullable[bool]         `json:"auto_transition_deliver_notifications_at_end,omitempty" replace:"omitempty"`
+	AutoTransitionDeliverNotificationsAtStart Nullable[bool]         `json:"auto_transition_deliver_notifications_at_start,omitempty" replace:"omitempty"`
+	AutoTransitionToMaintenanceState          Nullable[bool]         `json:"auto_transition_to_maintenance_state,omitempty" replace:"omitempty"`
+	AutoTransitionToOperationalState          Nullable[bool]         `json:"auto_transition_to_operational_state,omitempty" replace:"omitempty"`
+	AutoTweetAtBeginning                      Nullable[bool]         `json:"auto_tweet_at_beginning,omitempty" replace:"omitempty"`
+	AutoTweetOnCompletion                     Nullable[bool]         `json:"auto_tweet_on_completion,omitempty" replace:"omitempty"`
+	AutoTweetOnCreation                       Nullable[bool]         `json:"auto_tweet_on_creation,omitempty" replace:"omitempty"`
+	AutoTweetOneHourBefore                    Nullable[bool]         `json:"auto_tweet_one_hour_before,omitempty" replace:"omitempty"`
+	BackfillDate                              string                 `json:"backfill_date,omitempty" replace:"omitempty"`
+	Backfilled                                Nullable[bool]         `json:"backfilled,omitempty" replace:"omitempty"`
 	Metadata                                  map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -53,6 +53,24 @@ type IncidentListOptions struct {
 	PerPage int    `url:"per_page,omitempty"`
 }
 
+// startPage returns the first page to request when walking incidents
+func (o *IncidentListOptions) startPage() int {
+	if o == nil {
+		return 1
+	}
+	return o.Page
+}
+
+// forPage copies the list options with the page number replaced
+func (o *IncidentListOptions) forPage(page int) *IncidentListOptions {
+	opts := IncidentListOptions{}
+	if o != nil {
+		opts = *o
+	}
+	opts.Page = page
+	return &opts
+}
+
 // Incident status and impact level constants for managing incident lifecycle
 const (
 	IncidentStatusInvestigating = "investigating"
@@ -72,109 +90,180 @@ const (
 )
 
 // List retrieves incidents for a status page with optional filtering and pagination
-func (s *IncidentsService) List(ctx context.Context, pageID string, opts *IncidentListOptions) ([]*Incident, error) {
+func (s *IncidentsService) List(ctx context.Context, pageID string, opts *IncidentListOptions) ([]*Incident, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents", pageID)
 	u, err := addOptions(u, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var incidents []*Incident
-	_, err = s.client.Do(ctx, req, &incidents)
+	resp, err := s.client.Do(ctx, req, &incidents)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return incidents, nil
+	return incidents, resp, nil
 }
 
-// ListUnresolved retrieves all active incidents that have not been resolved
-func (s *IncidentsService) ListUnresolved(ctx context.Context, pageID string) ([]*Incident, error) {
-	u := fmt.Sprintf("pages/%s/incidents/unresolved", pageID)
-	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return nil, err
-	}
+// ListAll retrieves every incident matching the filters by walking through all result pages
+func (s *IncidentsService) ListAll(ctx context.Context, pageID string, opts *IncidentListOptions) ([]*Incident, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Incident, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
+}
 
-	var incidents []*Incident
-	_, err = s.client.Do(ctx, req, &incidents)
-	if err != nil {
-		return nil, err
-	}
+// Walk calls fn for every incident matching the filters, requesting further pages until an empty page is returned
+func (s *IncidentsService) Walk(ctx context.Context, pageID string, opts *IncidentListOptions, fn func(*Incident) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*Incident, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
+}
+
+// ListUnresolved retrieves a single page of active incidents that have not been resolved
+func (s *IncidentsService) ListUnresolved(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listFiltered(ctx, pageID, "unresolved", opts)
+}
+
+// ListAllUnresolved retrieves every unresolved incident by walking through all result pages
+func (s *IncidentsService) ListAllUnresolved(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listAllFiltered(ctx, pageID, "unresolved", opts)
+}
 
-	return incidents, nil
+// ListScheduled retrieves a single page of scheduled maintenance incidents for future events
+func (s *IncidentsService) ListScheduled(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listFiltered(ctx, pageID, "scheduled", opts)
 }
 
-// ListScheduled retrieves all scheduled maintenance incidents for future events
-func (s *IncidentsService) ListScheduled(ctx context.Context, pageID string) ([]*Incident, error) {
-	u := fmt.Sprintf("pages/%s/incidents/scheduled", pageID)
+// ListAllScheduled retrieves every scheduled maintenance incident by walking through all result pages
+func (s *IncidentsService) ListAllScheduled(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listAllFiltered(ctx, pageID, "scheduled", opts)
+}
+
+// ListUpcoming retrieves a single page of scheduled maintenances that have not started yet
+func (s *IncidentsService) ListUpcoming(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listFiltered(ctx, pageID, "upcoming", opts)
+}
+
+// ListAllUpcoming retrieves every maintenance that has not started yet by walking through all result pages
+func (s *IncidentsService) ListAllUpcoming(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listAllFiltered(ctx, pageID, "upcoming", opts)
+}
+
+// ListActiveMaintenance retrieves a single page of maintenances that are currently in progress or being verified
+func (s *IncidentsService) ListActiveMaintenance(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listFiltered(ctx, pageID, "active_maintenance", opts)
+}
+
+// ListAllActiveMaintenance retrieves every maintenance in progress or being verified by walking through all
+// result pages
+func (s *IncidentsService) ListAllActiveMaintenance(ctx context.Context, pageID string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return s.listAllFiltered(ctx, pageID, "active_maintenance", opts)
+}
+
+// listFiltered retrieves a single page of one of the filtered incident listings, such as unresolved
+func (s *IncidentsService) listFiltered(ctx context.Context, pageID, filter string, opts *ListOptions) ([]*Incident, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s", pageID, filter)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var incidents []*Incident
-	_, err = s.client.Do(ctx, req, &incidents)
+	resp, err := s.client.Do(ctx, req, &incidents)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return incidents, nil
+	return incidents, resp, nil
+}
+
+// listAllFiltered walks every page of one of the filtered incident listings
+func (s *IncidentsService) listAllFiltered(ctx context.Context, pageID, filter string, opts *ListOptions) ([]*Incident, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Incident, *Response, error) {
+		return s.listFiltered(ctx, pageID, filter, opts.forPage(page))
+	})
 }
 
-func (s *IncidentsService) Get(ctx context.Context, pageID, incidentID string) (*Incident, error) {
+func (s *IncidentsService) Get(ctx context.Context, pageID, incidentID string) (*Incident, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents/%s", pageID, incidentID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	incident := new(Incident)
-	_, err = s.client.Do(ctx, req, incident)
+	resp, err := s.client.Do(ctx, req, incident)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return incident, nil
+	return incident, resp, nil
 }
 
-func (s *IncidentsService) Create(ctx context.Context, pageID string, incident *IncidentInput) (*Incident, error) {
+func (s *IncidentsService) Create(ctx context.Context, pageID string, incident *IncidentInput) (*Incident, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents", pageID)
 	incidentReq := &IncidentRequest{Incident: incident}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, incidentReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newIncident := new(Incident)
-	_, err = s.client.Do(ctx, req, newIncident)
+	resp, err := s.client.Do(ctx, req, newIncident)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newIncident, nil
+	return newIncident, resp, nil
 }
 
-func (s *IncidentsService) Update(ctx context.Context, pageID, incidentID string, incident *IncidentInput) (*Incident, error) {
+func (s *IncidentsService) Update(ctx context.Context, pageID, incidentID string, incident *IncidentInput) (*Incident, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incidents/%s", pageID, incidentID)
 	incidentReq := &IncidentRequest{Incident: incident}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, incidentReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedIncident := new(Incident)
-	_, err = s.client.Do(ctx, req, updatedIncident)
+	resp, err := s.client.Do(ctx, req, updatedIncident)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
+	}
+
+	return updatedIncident, resp, nil
+}
+
+// Replace overwrites every editable field of an incident, clearing those left unset in the input
+func (s *IncidentsService) Replace(ctx context.Context, pageID, incidentID string, incident *IncidentInput) (*Incident, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s", pageID, incidentID)
+	incidentReq, err := replaceRequest("incident", incident)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, incidentReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedIncident := new(Incident)
+	resp, err := s.client.Do(ctx, req, updatedIncident)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return updatedIncident, nil
+	return updatedIncident, resp, nil
 }
 
 func (s *IncidentsService) Delete(ctx context.Context, pageID, incidentID string) (*Response, error) {
@@ -0,0 +1,131 @@
+package statuspage
+
+import (
+	"context"
+	"time"
+)
+
+// MaintenanceWindow is the period during which a maintenance incident covers its components
+type MaintenanceWindow struct {
+	Incident *Incident
+	Start    time.Time
+	// End is zero when the maintenance has no scheduled end
+	End          time.Time
+	ComponentIDs []string
+}
+
+// MaintenanceSchedule is a set of maintenance windows that can be queried without further API requests
+type MaintenanceSchedule []*MaintenanceWindow
+
+// NewMaintenanceWindow describes the window of a maintenance incident. It returns nil for incidents that
+// are not maintenances or have already completed.
+func NewMaintenanceWindow(incident *Incident) *MaintenanceWindow {
+	switch incident.Status {
+	case IncidentStatusScheduled, IncidentStatusInProgress, IncidentStatusVerifying:
+	default:
+		return nil
+	}
+
+	w := &MaintenanceWindow{Incident: incident}
+	if incident.ScheduledFor != nil {
+		w.Start = incident.ScheduledFor.Time
+	}
+	if incident.ScheduledUntil != nil {
+		w.End = incident.ScheduledUntil.Time
+	}
+
+	seen := map[string]bool{}
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			w.ComponentIDs = append(w.ComponentIDs, id)
+		}
+	}
+	for _, component := range incident.Components {
+		add(component.ID)
+	}
+	for _, id := range incident.ComponentIDs {
+		add(id)
+	}
+
+	return w
+}
+
+// ActiveAt reports whether the maintenance is in effect at t. A maintenance that has been started stays in
+// effect past its scheduled end until it is completed, while one still awaiting its start is judged by its
+// scheduled times alone.
+func (w *MaintenanceWindow) ActiveAt(t time.Time) bool {
+	if !w.Start.IsZero() && t.Before(w.Start) {
+		return false
+	}
+
+	switch w.Incident.Status {
+	case IncidentStatusInProgress, IncidentStatusVerifying:
+		return true
+	case IncidentStatusScheduled:
+		return !w.Start.IsZero() && (w.End.IsZero() || t.Before(w.End))
+	default:
+		return false
+	}
+}
+
+// Covers reports whether the maintenance affects the component
+func (w *MaintenanceWindow) Covers(componentID string) bool {
+	for _, id := range w.ComponentIDs {
+		if id == componentID {
+			return true
+		}
+	}
+	return false
+}
+
+// WindowFor returns the maintenance window covering the component at t, or nil when the component is not
+// under maintenance
+func (s MaintenanceSchedule) WindowFor(componentID string, t time.Time) *MaintenanceWindow {
+	for _, w := range s {
+		if w.Covers(componentID) && w.ActiveAt(t) {
+			return w
+		}
+	}
+	return nil
+}
+
+// MaintenanceSchedule retrieves every active and scheduled maintenance of a page, across all result pages, as
+// maintenance windows
+func (s *IncidentsService) MaintenanceSchedule(ctx context.Context, pageID string) (MaintenanceSchedule, *Response, error) {
+	active, resp, err := s.ListAllActiveMaintenance(ctx, pageID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	scheduled, resp, err := s.ListAllScheduled(ctx, pageID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var schedule MaintenanceSchedule
+	seen := map[string]bool{}
+	for _, incident := range append(active, scheduled...) {
+		if seen[incident.ID] {
+			continue
+		}
+		seen[incident.ID] = true
+		if w := NewMaintenanceWindow(incident); w != nil {
+			schedule = append(schedule, w)
+		}
+	}
+
+	return schedule, resp, nil
+}
+
+// ComponentInMaintenance returns the maintenance window covering the component at t, or nil when the
+// component is not under maintenance. Callers checking many components should fetch the MaintenanceSchedule
+// once and query it with WindowFor instead.
+func (s *IncidentsService) ComponentInMaintenance(ctx context.Context, pageID, componentID string, t time.Time) (*MaintenanceWindow, *Response, error) {
+	schedule, resp, err := s.MaintenanceSchedule(ctx, pageID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return schedule.WindowFor(componentID, t), resp, nil
+}
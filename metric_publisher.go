@@ -0,0 +1,307 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultPublishInterval is the aggregation and flush interval used when none is configured
+const DefaultPublishInterval = time.Minute
+
+// DefaultFlushTimeout bounds each background and closing flush when no timeout is configured
+const DefaultFlushTimeout = 30 * time.Second
+
+// ErrPublisherClosed is reported for samples added after a MetricPublisher has been closed
+var ErrPublisherClosed = errors.New("statuspage: metric publisher is closed")
+
+// Aggregation selects how the samples collected for a metric within one interval become a single data point
+type Aggregation int
+
+// Aggregations supported by MetricPublisher
+const (
+	AggregateLast Aggregation = iota
+	AggregateAverage
+	AggregateMax
+)
+
+// PublisherOption is a functional option for configuring a MetricPublisher
+type PublisherOption func(*MetricPublisher)
+
+// WithPublishInterval sets how long samples are aggregated before being flushed as one data point
+func WithPublishInterval(interval time.Duration) PublisherOption {
+	return func(p *MetricPublisher) {
+		if interval > 0 {
+			p.interval = interval
+		}
+	}
+}
+
+// WithFlushTimeout bounds how long a background flush, or the final flush in Close, may take before its
+// outstanding requests are cancelled and their points dropped
+func WithFlushTimeout(timeout time.Duration) PublisherOption {
+	return func(p *MetricPublisher) {
+		if timeout > 0 {
+			p.flushTimeout = timeout
+		}
+	}
+}
+
+// WithAggregation sets how samples within an interval are combined. The default is AggregateLast.
+func WithAggregation(aggregation Aggregation) PublisherOption {
+	return func(p *MetricPublisher) {
+		p.aggregation = aggregation
+	}
+}
+
+// WithDropHandler registers fn to be called whenever data points are discarded, either because a flush
+// failed after the client's retries or because samples arrived after Close
+func WithDropHandler(fn func(metricID string, points int, err error)) PublisherOption {
+	return func(p *MetricPublisher) {
+		p.onDrop = fn
+	}
+}
+
+// MetricPublisher buffers metric samples from any number of goroutines, aggregates them per interval and
+// submits them in batches of at most MaxMetricDataPointsPerRequest points. Requests go through the client, so
+// its retry and rate limit configuration apply.
+type MetricPublisher struct {
+	client       *Client
+	pageID       string
+	interval     time.Duration
+	flushTimeout time.Duration
+	aggregation  Aggregation
+	onDrop       func(metricID string, points int, err error)
+
+	mu      sync.Mutex
+	buckets map[string]map[int64]*sampleBucket
+	closed  bool
+
+	// flushMu keeps the ticker, Flush and Close from submitting the same interval twice
+	flushMu sync.Mutex
+	dropped atomic.Int64
+
+	// runCtx is the parent of background flushes; cancelRun aborts them when Close gives up waiting
+	runCtx    context.Context
+	cancelRun context.CancelFunc
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// sampleBucket accumulates the samples of one metric within one interval
+type sampleBucket struct {
+	sum      float64
+	count    int
+	max      float64
+	last     float64
+	lastSeen time.Time
+}
+
+// NewMetricPublisher starts a publisher that flushes completed intervals for the page in the background.
+// Callers must Close it to flush the remaining samples and stop the background goroutine.
+func NewMetricPublisher(client *Client, pageID string, opts ...PublisherOption) *MetricPublisher {
+	p := &MetricPublisher{
+		client:       client,
+		pageID:       pageID,
+		interval:     DefaultPublishInterval,
+		flushTimeout: DefaultFlushTimeout,
+		buckets:      map[string]map[int64]*sampleBucket{},
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	p.runCtx, p.cancelRun = context.WithCancel(context.Background())
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	go p.run()
+	return p
+}
+
+// Add records a sample for the metric at the current time
+func (p *MetricPublisher) Add(metricID string, value float64) {
+	p.AddAt(metricID, time.Now(), value)
+}
+
+// AddAt records a sample for the metric at t
+func (p *MetricPublisher) AddAt(metricID string, t time.Time, value float64) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		p.drop(metricID, 1, ErrPublisherClosed)
+		return
+	}
+
+	start := t.Truncate(p.interval).Unix()
+	metricBuckets, ok := p.buckets[metricID]
+	if !ok {
+		metricBuckets = map[int64]*sampleBucket{}
+		p.buckets[metricID] = metricBuckets
+	}
+	b, ok := metricBuckets[start]
+	if !ok {
+		b = &sampleBucket{max: value}
+		metricBuckets[start] = b
+	}
+
+	b.sum += value
+	b.count++
+	if value > b.max {
+		b.max = value
+	}
+	if !t.Before(b.lastSeen) {
+		b.last = value
+		b.lastSeen = t
+	}
+	p.mu.Unlock()
+}
+
+// Flush submits every buffered sample, including those of the interval still in progress
+func (p *MetricPublisher) Flush(ctx context.Context) error {
+	return p.flush(ctx, time.Time{})
+}
+
+// Close stops the background flushes and submits the remaining samples within the flush timeout. When ctx is
+// done first, a background flush still in progress and the final flush are cancelled and their points dropped.
+// Samples added afterwards are dropped.
+func (p *MetricPublisher) Close(ctx context.Context) error {
+	p.closeOnce.Do(func() {
+		p.mu.Lock()
+		p.closed = true
+		p.mu.Unlock()
+
+		closed := make(chan struct{})
+		defer close(closed)
+		go func() {
+			select {
+			case <-ctx.Done():
+				p.cancelRun()
+			case <-closed:
+			}
+		}()
+		defer p.cancelRun()
+
+		close(p.stop)
+		<-p.done
+
+		flushCtx, cancel := context.WithTimeout(ctx, p.flushTimeout)
+		defer cancel()
+		p.closeErr = p.Flush(flushCtx)
+	})
+	return p.closeErr
+}
+
+// Dropped returns the number of data points discarded so far
+func (p *MetricPublisher) Dropped() int64 {
+	return p.dropped.Load()
+}
+
+// run flushes completed intervals until the publisher is closed
+func (p *MetricPublisher) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case now := <-ticker.C:
+			ctx, cancel := context.WithTimeout(p.runCtx, p.flushTimeout)
+			// Errors are reported through the drop handler
+			_ = p.flush(ctx, now)
+			cancel()
+		}
+	}
+}
+
+// flush submits the buffered intervals that ended at or before until, or every interval when until is zero.
+// Each request is sent even if an earlier one failed; only the points of failed requests are dropped and
+// reported, rather than kept for the next flush. The first error is returned.
+func (p *MetricPublisher) flush(ctx context.Context, until time.Time) error {
+	p.flushMu.Lock()
+	defer p.flushMu.Unlock()
+
+	data := p.take(until)
+	if len(data) == 0 {
+		return nil
+	}
+
+	var firstErr error
+	for _, chunk := range chunkMetricData(data, MaxMetricDataPointsPerRequest) {
+		if _, err := p.client.Metrics.addBulkChunk(ctx, p.pageID, chunk); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			metricIDs := make([]string, 0, len(chunk))
+			for metricID := range chunk {
+				metricIDs = append(metricIDs, metricID)
+			}
+			sort.Strings(metricIDs)
+			for _, metricID := range metricIDs {
+				p.drop(metricID, len(chunk[metricID]), err)
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// take removes the selected intervals from the buffer and aggregates each into a data point
+func (p *MetricPublisher) take(until time.Time) map[string][]*MetricDataInput {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	data := map[string][]*MetricDataInput{}
+	for metricID, metricBuckets := range p.buckets {
+		for start, b := range metricBuckets {
+			bucketStart := time.Unix(start, 0)
+			if !until.IsZero() && bucketStart.Add(p.interval).After(until) {
+				continue
+			}
+
+			data[metricID] = append(data[metricID], &MetricDataInput{
+				Timestamp: bucketStart,
+				Value:     b.aggregate(p.aggregation),
+			})
+			delete(metricBuckets, start)
+		}
+		if len(metricBuckets) == 0 {
+			delete(p.buckets, metricID)
+		}
+	}
+
+	for _, points := range data {
+		sort.Slice(points, func(i, j int) bool {
+			return points[i].Timestamp.Before(points[j].Timestamp)
+		})
+	}
+	return data
+}
+
+// drop counts discarded points and reports them to the drop handler
+func (p *MetricPublisher) drop(metricID string, points int, err error) {
+	p.dropped.Add(int64(points))
+	if p.onDrop != nil {
+		p.onDrop(metricID, points, err)
+	}
+}
+
+// aggregate combines the bucket's samples into a single value
+func (b *sampleBucket) aggregate(aggregation Aggregation) float64 {
+	switch aggregation {
+	case AggregateAverage:
+		return b.sum / float64(b.count)
+	case AggregateMax:
+		return b.max
+	default:
+		return b.last
+	}
+}
@@ -0,0 +1,332 @@
+package statuspage_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// publisherStart is the start of an interval for publishers that aggregate by the hour
+var publisherStart = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// createMetrics creates metrics with the given names under a new self-reported metrics provider and returns
+// their IDs
+func createMetrics(t *testing.T, client *statuspage.Client, pageID string, names ...string) []string {
+	t.Helper()
+	ctx := context.Background()
+	provider, _, err := client.MetricsProviders.Create(ctx, pageID, &statuspage.MetricsProviderInput{Type: statuspage.MetricsProviderTypeSelf})
+	if err != nil {
+		t.Fatalf("MetricsProviders.Create: %v", err)
+	}
+
+	var ids []string
+	for _, name := range names {
+		metric, _, err := client.MetricsProviders.CreateMetric(ctx, pageID, provider.ID, &statuspage.ProviderMetricInput{
+			MetricInput: statuspage.MetricInput{Name: name},
+		})
+		if err != nil {
+			t.Fatalf("MetricsProviders.CreateMetric(%q): %v", name, err)
+		}
+		ids = append(ids, metric.ID)
+	}
+	return ids
+}
+
+// metricPoints returns the data points stored for a metric
+func metricPoints(t *testing.T, client *statuspage.Client, pageID, metricID string) []*statuspage.MetricData {
+	t.Helper()
+	points, _, err := client.Metrics.GetData(context.Background(), pageID, metricID, nil)
+	if err != nil {
+		t.Fatalf("Metrics.GetData(%q): %v", metricID, err)
+	}
+	return points
+}
+
+// dropRecorder collects the calls made to a publisher's drop handler
+type dropRecorder struct {
+	mu    sync.Mutex
+	drops []recordedDrop
+}
+
+type recordedDrop struct {
+	metricID string
+	points   int
+	err      error
+}
+
+func (d *dropRecorder) handle(metricID string, points int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drops = append(d.drops, recordedDrop{metricID, points, err})
+}
+
+func (d *dropRecorder) calls() []recordedDrop {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]recordedDrop(nil), d.drops...)
+}
+
+// blockingTransport holds every request until its context is done
+type blockingTransport struct {
+	once    sync.Once
+	started chan struct{}
+}
+
+func (b *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	b.once.Do(func() { close(b.started) })
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestMetricPublisherBuffersUntilFlush(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ids := createMetrics(t, client, pageID, "Latency", "Errors")
+	publisher := statuspage.NewMetricPublisher(client, pageID, statuspage.WithPublishInterval(time.Hour))
+	defer publisher.Close(context.Background())
+
+	publisher.AddAt(ids[0], publisherStart.Add(time.Minute), 1)
+	publisher.AddAt(ids[0], publisherStart.Add(70*time.Minute), 2)
+	publisher.AddAt(ids[1], publisherStart.Add(2*time.Minute), 3)
+	for _, id := range ids {
+		if points := metricPoints(t, client, pageID, id); len(points) != 0 {
+			t.Errorf("metric %s has %d points before Flush, want 0", id, len(points))
+		}
+	}
+
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	points := metricPoints(t, client, pageID, ids[0])
+	if len(points) != 2 {
+		t.Fatalf("metric %s has %d points after Flush, want one per interval", ids[0], len(points))
+	}
+	if !points[0].Timestamp.Equal(publisherStart) || !points[1].Timestamp.Equal(publisherStart.Add(time.Hour)) {
+		t.Errorf("timestamps = %v, %v, want the interval starts", points[0].Timestamp, points[1].Timestamp)
+	}
+	if points := metricPoints(t, client, pageID, ids[1]); len(points) != 1 || points[0].Value != 3 {
+		t.Errorf("metric %s points = %+v, want one point of 3", ids[1], points)
+	}
+
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Fatalf("second Flush: %v", err)
+	}
+	if points := metricPoints(t, client, pageID, ids[0]); len(points) != 2 {
+		t.Errorf("metric %s has %d points after a second Flush, want samples submitted once", ids[0], len(points))
+	}
+}
+
+func TestMetricPublisherFlushesCompletedIntervals(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ids := createMetrics(t, client, pageID, "Latency")
+	publisher := statuspage.NewMetricPublisher(client, pageID, statuspage.WithPublishInterval(20*time.Millisecond))
+	defer publisher.Close(context.Background())
+
+	publisher.Add(ids[0], 42)
+	deadline := time.Now().Add(5 * time.Second)
+	for len(metricPoints(t, client, pageID, ids[0])) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("completed interval was not flushed in the background")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMetricPublisherAggregation(t *testing.T) {
+	tests := []struct {
+		name        string
+		aggregation statuspage.Aggregation
+		want        float64
+	}{
+		{"last", statuspage.AggregateLast, 4},
+		{"average", statuspage.AggregateAverage, 5},
+		{"max", statuspage.AggregateMax, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, client, pageID := newFakeClient(t)
+			ids := createMetrics(t, client, pageID, "Latency")
+			publisher := statuspage.NewMetricPublisher(client, pageID,
+				statuspage.WithPublishInterval(time.Hour), statuspage.WithAggregation(tt.aggregation))
+
+			// Added out of order, so the last sample by time is not the last one added
+			publisher.AddAt(ids[0], publisherStart.Add(time.Second), 3)
+			publisher.AddAt(ids[0], publisherStart.Add(3*time.Second), 4)
+			publisher.AddAt(ids[0], publisherStart.Add(2*time.Second), 8)
+			if err := publisher.Close(context.Background()); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			points := metricPoints(t, client, pageID, ids[0])
+			if len(points) != 1 || points[0].Value != tt.want {
+				t.Errorf("points = %+v, want one point of %v", points, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetricPublisherDropsFailedChunks(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ids := createMetrics(t, client, pageID, "Latency")
+	drops := &dropRecorder{}
+	publisher := statuspage.NewMetricPublisher(client, pageID,
+		statuspage.WithPublishInterval(time.Hour), statuspage.WithDropHandler(drops.handle))
+	defer publisher.Close(context.Background())
+
+	// The first request is filled by the existing metric; the unknown one sorts last and fails on its own
+	for i := 0; i < statuspage.MaxMetricDataPointsPerRequest; i++ {
+		publisher.AddAt(ids[0], publisherStart.Add(time.Duration(i)*time.Hour), float64(i))
+	}
+	publisher.AddAt("zzzzzzzzzzzz", publisherStart, 1)
+	publisher.AddAt("zzzzzzzzzzzz", publisherStart.Add(time.Hour), 2)
+
+	err := publisher.Flush(context.Background())
+	if !errors.Is(err, statuspage.ErrNotFound) {
+		t.Errorf("Flush error = %v, want ErrNotFound", err)
+	}
+	if got := len(metricPoints(t, client, pageID, ids[0])); got != statuspage.MaxMetricDataPointsPerRequest {
+		t.Errorf("metric %s has %d points, want the successful chunk of %d", ids[0], got, statuspage.MaxMetricDataPointsPerRequest)
+	}
+	if got := publisher.Dropped(); got != 2 {
+		t.Errorf("Dropped() = %d, want 2", got)
+	}
+	calls := drops.calls()
+	if len(calls) != 1 || calls[0].metricID != "zzzzzzzzzzzz" || calls[0].points != 2 || !errors.Is(calls[0].err, statuspage.ErrNotFound) {
+		t.Errorf("drop handler calls = %+v, want one for the 2 points of the unknown metric", calls)
+	}
+}
+
+func TestMetricPublisherCloseFlushes(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ids := createMetrics(t, client, pageID, "Latency")
+	publisher := statuspage.NewMetricPublisher(client, pageID, statuspage.WithPublishInterval(time.Hour))
+
+	publisher.AddAt(ids[0], publisherStart, 7)
+	if err := publisher.Close(context.Background()); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := publisher.Close(context.Background()); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+
+	points := metricPoints(t, client, pageID, ids[0])
+	if len(points) != 1 || points[0].Value != 7 {
+		t.Errorf("points = %+v, want the sample flushed by Close", points)
+	}
+	if got := publisher.Dropped(); got != 0 {
+		t.Errorf("Dropped() = %d, want 0", got)
+	}
+}
+
+func TestMetricPublisherCloseTimeout(t *testing.T) {
+	// Each case leaves one point in a flush that never completes. A hung background flush is cancelled
+	// through runCtx, so its failure reaches only the drop handler and Close itself has nothing left to send.
+	tests := []struct {
+		name       string
+		background bool
+		opts       []statuspage.PublisherOption
+		ctx        func() (context.Context, context.CancelFunc)
+	}{
+		{
+			name: "context deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+		},
+		{
+			name: "flush timeout",
+			opts: []statuspage.PublisherOption{statuspage.WithFlushTimeout(50 * time.Millisecond)},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+		},
+		{
+			name:       "hung background flush",
+			background: true,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, _, pageID := newFakeClient(t)
+			transport := &blockingTransport{started: make(chan struct{})}
+			client := statuspage.NewClient("test-key", statuspage.WithBaseURL(fake.URL+"/v1/"),
+				statuspage.WithHTTPClient(&http.Client{Transport: transport}))
+			drops := &dropRecorder{}
+			interval := time.Hour
+			if tt.background {
+				interval = 10 * time.Millisecond
+			}
+			opts := append([]statuspage.PublisherOption{
+				statuspage.WithPublishInterval(interval), statuspage.WithDropHandler(drops.handle),
+			}, tt.opts...)
+			publisher := statuspage.NewMetricPublisher(client, pageID, opts...)
+
+			publisher.AddAt("metric", time.Now().Add(-time.Hour), 1)
+			if tt.background {
+				select {
+				case <-transport.started:
+				case <-time.After(5 * time.Second):
+					t.Fatal("background flush did not start")
+				}
+			}
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+			start := time.Now()
+			err := publisher.Close(ctx)
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("Close took %v", elapsed)
+			}
+			if tt.background && err != nil {
+				t.Errorf("Close error = %v, want nil", err)
+			}
+			if !tt.background && !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("Close error = %v, want context.DeadlineExceeded", err)
+			}
+
+			if got := publisher.Dropped(); got != 1 {
+				t.Errorf("Dropped() = %d, want 1", got)
+			}
+			if calls := drops.calls(); len(calls) != 1 || calls[0].metricID != "metric" || calls[0].err == nil {
+				t.Errorf("drop handler calls = %+v, want one for the unsent point", calls)
+			}
+		})
+	}
+}
+
+func TestMetricPublisherAddAfterClose(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ids := createMetrics(t, client, pageID, "Latency")
+	drops := &dropRecorder{}
+	publisher := statuspage.NewMetricPublisher(client, pageID,
+		statuspage.WithPublishInterval(time.Hour), statuspage.WithDropHandler(drops.handle))
+	if err := publisher.Close(context.Background()); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	publisher.Add(ids[0], 1)
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Errorf("Flush after Close: %v", err)
+	}
+
+	if points := metricPoints(t, client, pageID, ids[0]); len(points) != 0 {
+		t.Errorf("points = %+v, want none", points)
+	}
+	if got := publisher.Dropped(); got != 1 {
+		t.Errorf("Dropped() = %d, want 1", got)
+	}
+	want := []recordedDrop{{ids[0], 1, statuspage.ErrPublisherClosed}}
+	if calls := drops.calls(); !reflect.DeepEqual(calls, want) {
+		t.Errorf("drop handler calls = %+v, want %+v", calls, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 )
 
@@ -16,15 +17,15 @@ type MetricRequest struct {
 }
 
 type MetricInput struct {
-	Name          string  `json:"name,omitempty"`
-	Suffix        string  `json:"suffix,omitempty"`
-	YAxisMin      float64 `json:"y_axis_min,omitempty"`
-	YAxisMax      float64 `json:"y_axis_max,omitempty"`
-	YAxisHidden   *bool   `json:"y_axis_hidden,omitempty"`
-	Transform     string  `json:"transform,omitempty"`
-	DecimalPlaces int     `json:"decimal_places,omitempty"`
-	Tooltip       string  `json:"tooltip,omitempty"`
-	DisplayName   string  `json:"display_name,omitempty"`
+	Name          string            `json:"name,omitempty" replace:"omitempty"`
+	Suffix        Nullable[string]  `json:"suffix,omitempty"`
+	YAxisMin      Nullable[float64] `json:"y_axis_min,omitempty"`
+	YAxisMax      Nullable[float64] `json:"y_axis_max,omitempty"`
+	YAxisHidden   Nullable[bool]    `json:"y_axis_hidden,omitempty"`
+	Transform     string            `json:"transform,omitempty" replace:"omitempty"`
+	DecimalPlaces Nullable[int]     `json:"decimal_places,omitempty"`
+	Tooltip       Nullable[string]  `json:"tooltip,omitempty"`
+	DisplayName   Nullable[string]  `json:"display_name,omitempty"`
 }
 
 type MetricDataRequest struct {
@@ -36,75 +37,125 @@ type MetricDataInput struct {
 	Value     float64   `json:"value"`
 }
 
+// MaxMetricDataPointsPerRequest is the most data points AddBulkData sends in a single request
+const MaxMetricDataPointsPerRequest = 3000
+
+// bulkMetricDataRequest is the body of a bulk data submission. The endpoint takes timestamps as Unix seconds.
+type bulkMetricDataRequest struct {
+	Data map[string][]bulkMetricDataPoint `json:"data"`
+}
+
+type bulkMetricDataPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
 type MetricDataListOptions struct {
 	From *time.Time `url:"from,omitempty"`
 	To   *time.Time `url:"to,omitempty"`
 }
 
-func (s *MetricsService) List(ctx context.Context, pageID string) ([]*Metric, error) {
+func (s *MetricsService) List(ctx context.Context, pageID string, opts *ListOptions) ([]*Metric, *Response, error) {
 	u := fmt.Sprintf("pages/%s/metrics", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var metrics []*Metric
-	_, err = s.client.Do(ctx, req, &metrics)
+	resp, err := s.client.Do(ctx, req, &metrics)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return metrics, nil
+	return metrics, resp, nil
+}
+
+func (s *MetricsService) ListAll(ctx context.Context, pageID string, opts *ListOptions) ([]*Metric, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Metric, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
 }
 
-func (s *MetricsService) Get(ctx context.Context, pageID, metricID string) (*Metric, error) {
+func (s *MetricsService) Walk(ctx context.Context, pageID string, opts *ListOptions, fn func(*Metric) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*Metric, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
+}
+
+func (s *MetricsService) Get(ctx context.Context, pageID, metricID string) (*Metric, *Response, error) {
 	u := fmt.Sprintf("pages/%s/metrics/%s", pageID, metricID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	metric := new(Metric)
-	_, err = s.client.Do(ctx, req, metric)
+	resp, err := s.client.Do(ctx, req, metric)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return metric, nil
+	return metric, resp, nil
 }
 
-func (s *MetricsService) Create(ctx context.Context, pageID string, metric *MetricInput) (*Metric, error) {
+func (s *MetricsService) Create(ctx context.Context, pageID string, metric *MetricInput) (*Metric, *Response, error) {
 	u := fmt.Sprintf("pages/%s/metrics", pageID)
 	metricReq := &MetricRequest{Metric: metric}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, metricReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newMetric := new(Metric)
-	_, err = s.client.Do(ctx, req, newMetric)
+	resp, err := s.client.Do(ctx, req, newMetric)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newMetric, nil
+	return newMetric, resp, nil
 }
 
-func (s *MetricsService) Update(ctx context.Context, pageID, metricID string, metric *MetricInput) (*Metric, error) {
+func (s *MetricsService) Update(ctx context.Context, pageID, metricID string, metric *MetricInput) (*Metric, *Response, error) {
 	u := fmt.Sprintf("pages/%s/metrics/%s", pageID, metricID)
 	metricReq := &MetricRequest{Metric: metric}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, metricReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedMetric := new(Metric)
-	_, err = s.client.Do(ctx, req, updatedMetric)
+	resp, err := s.client.Do(ctx, req, updatedMetric)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updatedMetric, nil
+	return updatedMetric, resp, nil
+}
+
+func (s *MetricsService) Replace(ctx context.Context, pageID, metricID string, metric *MetricInput) (*Metric, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics/%s", pageID, metricID)
+	metricReq, err := replaceRequest("metric", metric)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, metricReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedMetric := new(Metric)
+	resp, err := s.client.Do(ctx, req, updatedMetric)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedMetric, resp, nil
 }
 
 func (s *MetricsService) Delete(ctx context.Context, pageID, metricID string) (*Response, error) {
@@ -122,42 +173,102 @@ func (s *MetricsService) Delete(ctx context.Context, pageID, metricID string) (*
 	return resp, nil
 }
 
-func (s *MetricsService) AddData(ctx context.Context, pageID, metricID string, data *MetricDataInput) (*MetricData, error) {
+func (s *MetricsService) AddData(ctx context.Context, pageID, metricID string, data *MetricDataInput) (*MetricData, *Response, error) {
 	u := fmt.Sprintf("pages/%s/metrics/%s/data", pageID, metricID)
 	dataReq := &MetricDataRequest{Data: data}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, dataReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	metricData := new(MetricData)
-	_, err = s.client.Do(ctx, req, metricData)
+	resp, err := s.client.Do(ctx, req, metricData)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return metricData, resp, nil
+}
+
+// AddBulkData submits data points for many metrics, keyed by metric ID. Large submissions are split into
+// requests of at most MaxMetricDataPointsPerRequest points, sent in order of metric ID; the first failing
+// request stops the submission and its error is returned. Requests sent before the failure were accepted.
+func (s *MetricsService) AddBulkData(ctx context.Context, pageID string, data map[string][]*MetricDataInput) (*Response, error) {
+	var resp *Response
+	for _, chunk := range chunkMetricData(data, MaxMetricDataPointsPerRequest) {
+		chunkResp, err := s.addBulkChunk(ctx, pageID, chunk)
+		if chunkResp != nil {
+			resp = chunkResp
+		}
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// addBulkChunk submits one request worth of data points
+func (s *MetricsService) addBulkChunk(ctx context.Context, pageID string, chunk map[string][]bulkMetricDataPoint) (*Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics/data", pageID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, &bulkMetricDataRequest{Data: chunk})
 	if err != nil {
 		return nil, err
 	}
 
-	return metricData, nil
+	return s.client.Do(ctx, req, nil)
+}
+
+// chunkMetricData splits data points into request bodies of at most size points each
+func chunkMetricData(data map[string][]*MetricDataInput, size int) []map[string][]bulkMetricDataPoint {
+	metricIDs := make([]string, 0, len(data))
+	for metricID := range data {
+		metricIDs = append(metricIDs, metricID)
+	}
+	sort.Strings(metricIDs)
+
+	var chunks []map[string][]bulkMetricDataPoint
+	var chunk map[string][]bulkMetricDataPoint
+	points := 0
+	for _, metricID := range metricIDs {
+		for _, point := range data[metricID] {
+			if point == nil {
+				continue
+			}
+			if chunk == nil || points == size {
+				chunk = map[string][]bulkMetricDataPoint{}
+				chunks = append(chunks, chunk)
+				points = 0
+			}
+			chunk[metricID] = append(chunk[metricID], bulkMetricDataPoint{
+				Timestamp: point.Timestamp.Unix(),
+				Value:     point.Value,
+			})
+			points++
+		}
+	}
+	return chunks
 }
 
-func (s *MetricsService) GetData(ctx context.Context, pageID, metricID string, opts *MetricDataListOptions) ([]*MetricData, error) {
+func (s *MetricsService) GetData(ctx context.Context, pageID, metricID string, opts *MetricDataListOptions) ([]*MetricData, *Response, error) {
 	u := fmt.Sprintf("pages/%s/metrics/%s/data", pageID, metricID)
 	u, err := addOptions(u, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var data []*MetricData
-	_, err = s.client.Do(ctx, req, &data)
+	resp, err := s.client.Do(ctx, req, &data)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return data, nil
+	return data, resp, nil
 }
 
 func (s *MetricsService) DeleteData(ctx context.Context, pageID, metricID string) (*Response, error) {
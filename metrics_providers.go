@@ -0,0 +1,206 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MetricsProvidersService handles the external data sources that feed metrics on a status page
+type MetricsProvidersService struct {
+	client *Client
+}
+
+// MetricsProviderRequest wraps metrics provider input data for API requests
+type MetricsProviderRequest struct {
+	MetricsProvider *MetricsProviderInput `json:"metrics_provider"`
+}
+
+// MetricsProviderInput contains the credentials and settings for creating or updating a metrics provider.
+// Which credentials are required depends on Type.
+type MetricsProviderInput struct {
+	Type           string `json:"type,omitempty" replace:"omitempty"`
+	Email          string `json:"email,omitempty"`
+	Password       string `json:"password,omitempty" replace:"omitempty"`
+	APIKey         string `json:"api_key,omitempty" replace:"omitempty"`
+	APIToken       string `json:"api_token,omitempty" replace:"omitempty"`
+	ApplicationKey string `json:"application_key,omitempty" replace:"omitempty"`
+	MetricBaseURI  string `json:"metric_base_uri,omitempty"`
+}
+
+// ProviderMetricInput contains the fields for creating a metric whose data is pulled from a metrics provider
+type ProviderMetricInput struct {
+	MetricInput
+	MetricIdentifier   string         `json:"metric_identifier,omitempty"`
+	ApplicationID      string         `json:"application_id,omitempty"`
+	Display            Nullable[bool] `json:"display,omitempty"`
+	TooltipDescription string         `json:"tooltip_description,omitempty"`
+}
+
+// ProviderMetricRequest wraps provider metric input data for API requests
+type ProviderMetricRequest struct {
+	Metric *ProviderMetricInput `json:"metric"`
+}
+
+// Metrics provider types supported by Statuspage
+const (
+	MetricsProviderTypePingdom  = "Pingdom"
+	MetricsProviderTypeNewRelic = "NewRelic"
+	MetricsProviderTypeLibrato  = "Librato"
+	MetricsProviderTypeDatadog  = "Datadog"
+	MetricsProviderTypeSelf     = "Self"
+)
+
+// List retrieves all metrics providers configured for a status page
+func (s *MetricsProvidersService) List(ctx context.Context, pageID string) ([]*MetricProvider, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers", pageID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var providers []*MetricProvider
+	resp, err := s.client.Do(ctx, req, &providers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return providers, resp, nil
+}
+
+// Get retrieves a specific metrics provider by its unique identifier
+func (s *MetricsProvidersService) Get(ctx context.Context, pageID, providerID string) (*MetricProvider, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers/%s", pageID, providerID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := new(MetricProvider)
+	resp, err := s.client.Do(ctx, req, provider)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return provider, resp, nil
+}
+
+// Create connects a new metrics provider such as Datadog, New Relic, Librato or Pingdom
+func (s *MetricsProvidersService) Create(ctx context.Context, pageID string, provider *MetricsProviderInput) (*MetricProvider, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers", pageID)
+	providerReq := &MetricsProviderRequest{MetricsProvider: provider}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, providerReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newProvider := new(MetricProvider)
+	resp, err := s.client.Do(ctx, req, newProvider)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newProvider, resp, nil
+}
+
+// Update modifies an existing metrics provider
+func (s *MetricsProvidersService) Update(ctx context.Context, pageID, providerID string, provider *MetricsProviderInput) (*MetricProvider, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers/%s", pageID, providerID)
+	providerReq := &MetricsProviderRequest{MetricsProvider: provider}
+	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, providerReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedProvider := new(MetricProvider)
+	resp, err := s.client.Do(ctx, req, updatedProvider)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedProvider, resp, nil
+}
+
+// Replace overwrites every editable field of a metrics provider, clearing those left unset in the input
+func (s *MetricsProvidersService) Replace(ctx context.Context, pageID, providerID string, provider *MetricsProviderInput) (*MetricProvider, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers/%s", pageID, providerID)
+	providerReq, err := replaceRequest("metrics_provider", provider)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, providerReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedProvider := new(MetricProvider)
+	resp, err := s.client.Do(ctx, req, updatedProvider)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedProvider, resp, nil
+}
+
+// Delete disconnects a metrics provider from the status page
+func (s *MetricsProvidersService) Delete(ctx context.Context, pageID, providerID string) (*Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers/%s", pageID, providerID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// ListMetrics retrieves a single page of metrics fed by a metrics provider
+func (s *MetricsProvidersService) ListMetrics(ctx context.Context, pageID, providerID string, opts *ListOptions) ([]*Metric, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers/%s/metrics", pageID, providerID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metrics []*Metric
+	resp, err := s.client.Do(ctx, req, &metrics)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return metrics, resp, nil
+}
+
+// ListAllMetrics retrieves every metric fed by a metrics provider by walking through all result pages
+func (s *MetricsProvidersService) ListAllMetrics(ctx context.Context, pageID, providerID string, opts *ListOptions) ([]*Metric, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Metric, *Response, error) {
+		return s.ListMetrics(ctx, pageID, providerID, opts.forPage(page))
+	})
+}
+
+// CreateMetric adds a metric whose data is pulled from the given metrics provider
+func (s *MetricsProvidersService) CreateMetric(ctx context.Context, pageID, providerID string, metric *ProviderMetricInput) (*Metric, *Response, error) {
+	u := fmt.Sprintf("pages/%s/metrics_providers/%s/metrics", pageID, providerID)
+	metricReq := &ProviderMetricRequest{Metric: metric}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, metricReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newMetric := new(Metric)
+	resp, err := s.client.Do(ctx, req, newMetric)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newMetric, resp, nil
+}
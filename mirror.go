@@ -0,0 +1,370 @@
+package statuspage
+
+import (
+	"context"
+	"sync"
+)
+
+// MirrorMetadataKey is the incident metadata namespace in which a Mirror records the upstream incident that
+// a mirrored incident was created from
+const MirrorMetadataKey = "mirror"
+
+// mirrorResolvedBody is posted when an upstream incident disappears without a final update being available
+const mirrorResolvedBody = "This incident has been resolved upstream."
+
+// MirrorOption is a functional option for configuring a Mirror
+type MirrorOption func(*Mirror)
+
+// WithMirrorComponents maps upstream components to component IDs on our page. Keys may be either the
+// upstream component ID or its name. Upstream components without a mapping are not mirrored.
+func WithMirrorComponents(components map[string]string) MirrorOption {
+	return func(m *Mirror) {
+		for upstream, id := range components {
+			m.components[upstream] = id
+		}
+	}
+}
+
+// WithMirrorNamePrefix sets a prefix for the names of mirrored incidents, such as "[GitHub] "
+func WithMirrorNamePrefix(prefix string) MirrorOption {
+	return func(m *Mirror) {
+		m.namePrefix = prefix
+	}
+}
+
+// WithMirrorNotifications sets whether mirrored incidents and updates notify our subscribers. When not set the
+// page default applies.
+func WithMirrorNotifications(deliver bool) MirrorOption {
+	return func(m *Mirror) {
+		m.deliver = NewNullable(deliver)
+	}
+}
+
+// WithMirrorErrorHandler registers fn to be called when Run fails to mirror an event
+func WithMirrorErrorHandler(fn func(event WatchEvent, err error)) MirrorOption {
+	return func(m *Mirror) {
+		m.onError = fn
+	}
+}
+
+// Mirror copies incidents from third-party status pages onto one of our pages. Each upstream incident gets a
+// linked incident, created through IncidentsService.Create, and every later upstream update is posted to it
+// through IncidentUpdatesService.Create. The link is stored in the incident metadata under MirrorMetadataKey,
+// so a restarted Mirror picks up the incidents it created before instead of duplicating them.
+type Mirror struct {
+	client     *Client
+	pageID     string
+	components map[string]string
+	namePrefix string
+	deliver    Nullable[bool]
+	onError    func(event WatchEvent, err error)
+
+	mu     sync.Mutex
+	linked map[string]*Incident
+}
+
+// mirrorLink identifies the upstream incident and the last upstream update mirrored onto an incident
+type mirrorLink struct {
+	Source     string
+	IncidentID string
+	UpdateID   string
+}
+
+// NewMirror creates a mirror that maintains linked incidents on the page identified by pageID
+func NewMirror(client *Client, pageID string, opts ...MirrorOption) *Mirror {
+	m := &Mirror{
+		client:     client,
+		pageID:     pageID,
+		components: map[string]string{},
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Run mirrors the incident events received from a Watcher until the channel is closed or ctx is done. Events
+// that fail are reported to the error handler and skipped.
+func (m *Mirror) Run(ctx context.Context, events <-chan WatchEvent) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if _, err := m.Sync(ctx, event); err != nil && m.onError != nil {
+				m.onError(event, err)
+			}
+		}
+	}
+}
+
+// Sync mirrors one Watcher event and returns the linked incident. Events other than incidents being opened,
+// updated or resolved are ignored, as are resolutions of incidents that were never mirrored.
+func (m *Mirror) Sync(ctx context.Context, event WatchEvent) (*Incident, error) {
+	switch event.Type {
+	case WatchIncidentOpened, WatchIncidentUpdated, WatchIncidentResolved:
+	default:
+		return nil, nil
+	}
+	if event.Incident == nil {
+		return nil, nil
+	}
+
+	source := event.Page.ID
+	if source == "" {
+		source = event.PageURL
+	}
+	return m.SyncIncident(ctx, source, event.Incident, event.Type == WatchIncidentResolved)
+}
+
+// SyncIncident brings the incident linked to the upstream incident up to date, creating it when needed.
+// source identifies the upstream status page. resolved marks the upstream incident as over even if its final
+// update is not included.
+func (m *Mirror) SyncIncident(ctx context.Context, source string, upstream *Incident, resolved bool) (*Incident, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.load(ctx); err != nil {
+		return nil, err
+	}
+
+	key := mirrorKey(source, upstream.ID)
+	incident, ok := m.linked[key]
+	if !ok {
+		if resolved || isResolvedStatus(upstream.Status) {
+			return nil, nil
+		}
+		return m.create(ctx, source, upstream)
+	}
+	return m.update(ctx, incident, upstream, resolved)
+}
+
+// load indexes the incidents previously created by a mirror on the page, once
+func (m *Mirror) load(ctx context.Context) error {
+	if m.linked != nil {
+		return nil
+	}
+
+	linked := map[string]*Incident{}
+	_, err := m.client.Incidents.Walk(ctx, m.pageID, nil, func(incident *Incident) error {
+		if link, ok := mirrorLinkOf(incident); ok {
+			linked[mirrorKey(link.Source, link.IncidentID)] = incident
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.linked = linked
+	return nil
+}
+
+// create opens a linked incident reflecting the current state of the upstream incident
+func (m *Mirror) create(ctx context.Context, source string, upstream *Incident) (*Incident, error) {
+	link := mirrorLink{Source: source, IncidentID: upstream.ID}
+	input := &IncidentInput{
+		Name:                 m.namePrefix + upstream.Name,
+		Status:               mirrorStatus(upstream.Status),
+		DeliverNotifications: m.deliver,
+	}
+	if upstream.Impact != "" {
+		input.ImpactOverride = NewNullable(upstream.Impact)
+	}
+	if len(upstream.IncidentUpdates) > 0 {
+		latest := upstream.IncidentUpdates[0]
+		input.Body = latest.Body
+		link.UpdateID = latest.ID
+	}
+	input.Metadata = link.metadata(nil)
+
+	input.Components = map[string]string{}
+	for _, component := range upstream.Components {
+		if id, ok := m.componentID(component.ID, component.Name); ok {
+			input.Components[id] = component.Status
+			input.ComponentIDs = append(input.ComponentIDs, id)
+		}
+	}
+
+	incident, _, err := m.client.Incidents.Create(ctx, m.pageID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	m.linked[mirrorKey(source, upstream.ID)] = incident
+	return incident, nil
+}
+
+// update posts the upstream updates that have not been mirrored yet, oldest first, recording each one in the
+// link as soon as it is posted. Updates found on the linked incident, posted by a sync that failed before
+// recording them, are recorded without being posted again.
+func (m *Mirror) update(ctx context.Context, incident *Incident, upstream *Incident, resolved bool) (*Incident, error) {
+	link, _ := mirrorLinkOf(incident)
+
+	pending := upstream.IncidentUpdates
+	for i, update := range upstream.IncidentUpdates {
+		if update.ID == link.UpdateID {
+			pending = upstream.IncidentUpdates[:i]
+			break
+		}
+	}
+	if link.UpdateID != "" && len(pending) == len(upstream.IncidentUpdates) && len(pending) > 0 {
+		// The last mirrored update is no longer listed upstream; only mirror the latest one
+		pending = pending[:1]
+	}
+
+	impact := upstream.Impact
+	if impact == "" {
+		impact = incident.ImpactOverride
+	}
+
+	for i := len(pending) - 1; i >= 0; i-- {
+		update := pending[i]
+		if mirroredUpdate(incident, update) {
+			// Posted by an earlier sync that failed to record it in the link
+			link.UpdateID = update.ID
+			if err := m.saveLink(ctx, incident, link, impact); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		input := &IncidentUpdateInput{
+			Body:                 update.Body,
+			Status:               mirrorStatus(update.Status),
+			DeliverNotifications: m.deliver,
+		}
+		for _, affected := range update.AffectedComponents {
+			if id, ok := m.componentID(affected.Code, affected.Name); ok && affected.NewStatus != "" {
+				if input.Components == nil {
+					input.Components = map[string]string{}
+				}
+				input.Components[id] = affected.NewStatus
+				input.AffectedComponents = append(input.AffectedComponents, id)
+			}
+		}
+
+		posted, _, err := m.client.IncidentUpdates.Create(ctx, m.pageID, incident.ID, input)
+		if err != nil {
+			return nil, err
+		}
+		if posted.Status != "" {
+			incident.Status = posted.Status
+		}
+		incident.IncidentUpdates = append([]IncidentUpdate{*posted}, incident.IncidentUpdates...)
+
+		link.UpdateID = update.ID
+		if err := m.saveLink(ctx, incident, link, impact); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(pending) == 0 && impact != incident.ImpactOverride {
+		if err := m.saveLink(ctx, incident, link, impact); err != nil {
+			return nil, err
+		}
+	}
+
+	if (resolved || isResolvedStatus(upstream.Status)) && !isResolvedStatus(incident.Status) {
+		input := &IncidentUpdateInput{
+			Body:       mirrorResolvedBody,
+			Status:     IncidentStatusResolved,
+			Components: map[string]string{},
+		}
+		for _, id := range incident.ComponentIDs {
+			input.Components[id] = ComponentStatusOperational
+		}
+		if _, _, err := m.client.IncidentUpdates.Create(ctx, m.pageID, incident.ID, input); err != nil {
+			return nil, err
+		}
+		incident.Status = IncidentStatusResolved
+	}
+
+	return incident, nil
+}
+
+// saveLink stores the link and impact on the linked incident without posting an incident update
+func (m *Mirror) saveLink(ctx context.Context, incident *Incident, link mirrorLink, impact string) error {
+	input := &IncidentInput{Metadata: link.metadata(incident.Metadata)}
+	if impact != "" {
+		input.ImpactOverride = NewNullable(impact)
+	}
+
+	updated, _, err := m.client.Incidents.Update(ctx, m.pageID, incident.ID, input)
+	if err != nil {
+		return err
+	}
+
+	*incident = *updated
+	return nil
+}
+
+// mirroredUpdate reports whether the upstream update has already been posted to the linked incident, judged by
+// an update with the same body and status created no earlier than the upstream one
+func mirroredUpdate(incident *Incident, upstream IncidentUpdate) bool {
+	for _, existing := range incident.IncidentUpdates {
+		if existing.Body != upstream.Body || existing.Status != mirrorStatus(upstream.Status) {
+			continue
+		}
+		if upstream.CreatedAt.IsZero() || !existing.CreatedAt.Before(upstream.CreatedAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// componentID returns our component ID for an upstream component, looked up by ID and then by name
+func (m *Mirror) componentID(upstreamID, name string) (string, bool) {
+	if id, ok := m.components[upstreamID]; ok && upstreamID != "" {
+		return id, true
+	}
+	id, ok := m.components[name]
+	return id, ok && name != ""
+}
+
+// metadata returns a copy of existing incident metadata with the link stored under MirrorMetadataKey
+func (l mirrorLink) metadata(existing map[string]interface{}) map[string]interface{} {
+	metadata := make(map[string]interface{}, len(existing)+1)
+	for key, value := range existing {
+		metadata[key] = value
+	}
+	metadata[MirrorMetadataKey] = map[string]interface{}{
+		"source":      l.Source,
+		"incident_id": l.IncidentID,
+		"update_id":   l.UpdateID,
+	}
+	return metadata
+}
+
+// mirrorLinkOf reads the link stored on an incident created by a Mirror
+func mirrorLinkOf(incident *Incident) (mirrorLink, bool) {
+	fields, ok := incident.Metadata[MirrorMetadataKey].(map[string]interface{})
+	if !ok {
+		return mirrorLink{}, false
+	}
+
+	source, _ := fields["source"].(string)
+	incidentID, _ := fields["incident_id"].(string)
+	updateID, _ := fields["update_id"].(string)
+	if source == "" || incidentID == "" {
+		return mirrorLink{}, false
+	}
+	return mirrorLink{Source: source, IncidentID: incidentID, UpdateID: updateID}, true
+}
+
+// mirrorKey identifies an upstream incident across status pages
+func mirrorKey(source, incidentID string) string {
+	return source + "/" + incidentID
+}
+
+// mirrorStatus converts an upstream incident status to one our incidents accept
+func mirrorStatus(status string) string {
+	if status == "postmortem" {
+		return IncidentStatusResolved
+	}
+	return status
+}
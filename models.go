@@ -2,6 +2,7 @@ package statuspage
 
 import (
 	"encoding/json"
+	"reflect"
 	"time"
 )
 
@@ -177,6 +178,8 @@ type Component struct {
 	OnlyShowIfDegraded bool   `json:"only_show_if_degraded,omitempty"`
 	AutomationEmail    string `json:"automation_email,omitempty"`
 	StartDate          *Time  `json:"start_date,omitempty"`
+	// Components lists the member component IDs when Group is set. Only the public status API reports it.
+	Components []string `json:"components,omitempty"`
 }
 
 // ComponentGroup represents a logical grouping of related components for better organization
@@ -216,10 +219,13 @@ type Incident struct {
 	ScheduledRemindedAt           *Time               `json:"scheduled_reminded_at,omitempty"`
 	ScheduledUntil                *Time               `json:"scheduled_until,omitempty"`
 	Shortlink                     string              `json:"shortlink,omitempty"`
+	StartedAt                     *Time               `json:"started_at,omitempty"`
 	Status                        string              `json:"status,omitempty"`
 	UpdatedAt                     Time                `json:"updated_at,omitempty"`
 	ComponentIDs                  []string            `json:"component_ids,omitempty"`
 	AffectedComponents            []AffectedComponent `json:"affected_components,omitempty"`
+	// Metadata holds integration data attached to the incident, keyed by namespace
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // IncidentUpdate represents a status update for an ongoing incident with communication details
@@ -239,6 +245,21 @@ type IncidentUpdate struct {
 	WantsTwitterUpdate   bool                `json:"wants_twitter_update,omitempty"`
 }
 
+// Postmortem represents the retrospective write-up attached to a resolved incident
+type Postmortem struct {
+	PreviewKey         string `json:"preview_key,omitempty"`
+	Body               string `json:"body,omitempty"`
+	BodyUpdatedAt      *Time  `json:"body_updated_at,omitempty"`
+	BodyDraft          string `json:"body_draft,omitempty"`
+	BodyDraftUpdatedAt *Time  `json:"body_draft_updated_at,omitempty"`
+	PublishedAt        *Time  `json:"published_at,omitempty"`
+	NotifySubscribers  bool   `json:"notify_subscribers,omitempty"`
+	NotifyTwitter      bool   `json:"notify_twitter,omitempty"`
+	CustomTweet        string `json:"custom_tweet,omitempty"`
+	CreatedAt          Time   `json:"created_at,omitempty"`
+	UpdatedAt          Time   `json:"updated_at,omitempty"`
+}
+
 // AffectedComponent represents a component impacted by an incident with status change information
 type AffectedComponent struct {
 	Code      string `json:"code,omitempty"`
@@ -247,6 +268,85 @@ type AffectedComponent struct {
 	NewStatus string `json:"new_status,omitempty"`
 }
 
+// OneOrMany is a list that also accepts a single bare value, for API fields documented as one shape but
+// sent as the other
+type OneOrMany[T any] []T
+
+// UnmarshalJSON accepts either a JSON array or a single value, ignoring a single zero value
+func (o *OneOrMany[T]) UnmarshalJSON(b []byte) error {
+	var list []T
+	if err := json.Unmarshal(b, &list); err == nil {
+		*o = list
+		return nil
+	}
+
+	var single T
+	if err := json.Unmarshal(b, &single); err != nil {
+		return err
+	}
+	if !reflect.ValueOf(&single).Elem().IsZero() {
+		*o = OneOrMany[T]{single}
+	}
+	return nil
+}
+
+// UptimeIncident identifies an incident that contributed to downtime within an uptime range
+type UptimeIncident struct {
+	ID string `json:"id,omitempty"`
+}
+
+// ComponentUptimeEvents lists the incidents that affected one component of a component group
+type ComponentUptimeEvents struct {
+	ComponentID string                    `json:"component_id,omitempty"`
+	Incidents   OneOrMany[UptimeIncident] `json:"incidents,omitempty"`
+}
+
+// ComponentUptime represents uptime statistics for a component over a date range
+type ComponentUptime struct {
+	ID               string                    `json:"id,omitempty"`
+	Name             string                    `json:"name,omitempty"`
+	RangeStart       Time                      `json:"range_start,omitempty"`
+	RangeEnd         Time                      `json:"range_end,omitempty"`
+	UptimePercentage float64                   `json:"uptime_percentage,omitempty"`
+	MajorOutage      int                       `json:"major_outage,omitempty"`
+	PartialOutage    int                       `json:"partial_outage,omitempty"`
+	Warnings         OneOrMany[string]         `json:"warnings,omitempty"`
+	RelatedEvents    OneOrMany[UptimeIncident] `json:"related_events,omitempty"`
+}
+
+// MajorOutageDuration returns the time spent in major outage within the range
+func (u *ComponentUptime) MajorOutageDuration() time.Duration {
+	return time.Duration(u.MajorOutage) * time.Second
+}
+
+// PartialOutageDuration returns the time spent in partial outage within the range
+func (u *ComponentUptime) PartialOutageDuration() time.Duration {
+	return time.Duration(u.PartialOutage) * time.Second
+}
+
+// ComponentGroupUptime represents uptime statistics for a component group over a date range
+type ComponentGroupUptime struct {
+	ID               string                           `json:"id,omitempty"`
+	Name             string                           `json:"name,omitempty"`
+	RangeStart       Time                             `json:"range_start,omitempty"`
+	RangeEnd         Time                             `json:"range_end,omitempty"`
+	UptimePercentage float64                          `json:"uptime_percentage,omitempty"`
+	MajorOutage      int                              `json:"major_outage,omitempty"`
+	PartialOutage    int                              `json:"partial_outage,omitempty"`
+	Warnings         OneOrMany[string]                `json:"warnings,omitempty"`
+	RelatedEvents    OneOrMany[ComponentUptimeEvents] `json:"related_events,omitempty"`
+}
+
+// MajorOutageDuration returns the time spent in major outage within the range
+func (u *ComponentGroupUptime) MajorOutageDuration() time.Duration {
+	return time.Duration(u.MajorOutage) * time.Second
+}
+
+// PartialOutageDuration returns the time spent in partial outage within the range
+func (u *ComponentGroupUptime) PartialOutageDuration() time.Duration {
+	return time.Duration(u.PartialOutage) * time.Second
+}
+
 // Subscriber represents a user subscribed to receive notifications about status updates
 type Subscriber struct {
 	ID                           string      `json:"id,omitempty"`
@@ -271,14 +371,47 @@ type Subscriber struct {
 	ComponentIDs                 []string    `json:"component_ids,omitempty"`
 }
 
+// SubscriberCountByType holds the number of subscribers of each type
+type SubscriberCountByType struct {
+	Email              int `json:"email"`
+	SMS                int `json:"sms"`
+	Webhook            int `json:"webhook"`
+	Slack              int `json:"slack"`
+	Teams              int `json:"teams"`
+	IntegrationPartner int `json:"integration_partner"`
+}
+
+// SubscriberCountByState holds the number of subscribers in each state
+type SubscriberCountByState struct {
+	Active      int `json:"active"`
+	Unconfirmed int `json:"unconfirmed"`
+	Quarantined int `json:"quarantined"`
+	Total       int `json:"total"`
+}
+
+// SubscriberCountByTypeAndState breaks subscriber counts down by type and then by state
+type SubscriberCountByTypeAndState struct {
+	Email              SubscriberCountByState `json:"email"`
+	SMS                SubscriberCountByState `json:"sms"`
+	Webhook            SubscriberCountByState `json:"webhook"`
+	Slack              SubscriberCountByState `json:"slack"`
+	Teams              SubscriberCountByState `json:"teams"`
+	IntegrationPartner SubscriberCountByState `json:"integration_partner"`
+}
+
 // Metric represents a performance metric displayed on the status page with configuration settings
 type Metric struct {
 	ID                  string     `json:"id,omitempty"`
 	PageID              string     `json:"page_id,omitempty"`
 	MetricsProviderID   string     `json:"metrics_provider_id,omitempty"`
+	MetricIdentifier    string     `json:"metric_identifier,omitempty"`
 	Name                string     `json:"name,omitempty"`
 	DisplayName         string     `json:"display_name,omitempty"`
+	Display             bool       `json:"display,omitempty"`
 	Tooltip             string     `json:"tooltip,omitempty"`
+	TooltipDescription  string     `json:"tooltip_description,omitempty"`
+	ReferenceName       string     `json:"reference_name,omitempty"`
+	Backfilled          bool       `json:"backfilled,omitempty"`
 	BackfillPercentage  float64    `json:"backfill_percentage,omitempty"`
 	BackfilledAt        *time.Time `json:"backfilled_at,omitempty"`
 	YAxisMin            float64    `json:"y_axis_min,omitempty"`
@@ -302,15 +435,18 @@ type MetricData struct {
 
 // MetricProvider represents a third-party service providing metric data with authentication details
 type MetricProvider struct {
-	ID             string    `json:"id,omitempty"`
-	Type           string    `json:"type,omitempty"`
-	Email          string    `json:"email,omitempty"`
-	Password       string    `json:"password,omitempty"`
-	APIKey         string    `json:"api_key,omitempty"`
-	APIToken       string    `json:"api_token,omitempty"`
-	ApplicationKey string    `json:"application_key,omitempty"`
-	CreatedAt      time.Time `json:"created_at,omitempty"`
-	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+	ID                string     `json:"id,omitempty"`
+	Type              string     `json:"type,omitempty"`
+	Email             string     `json:"email,omitempty"`
+	Password          string     `json:"password,omitempty"`
+	APIKey            string     `json:"api_key,omitempty"`
+	APIToken          string     `json:"api_token,omitempty"`
+	ApplicationKey    string     `json:"application_key,omitempty"`
+	Disabled          bool       `json:"disabled,omitempty"`
+	MetricBaseURI     string     `json:"metric_base_uri,omitempty"`
+	LastRevalidatedAt *time.Time `json:"last_revalidated_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at,omitempty"`
+	UpdatedAt         time.Time  `json:"updated_at,omitempty"`
 }
 
 // PageAccessUser represents a user with restricted access to specific components and metrics on audience-specific pages
@@ -368,6 +504,32 @@ type StatusEmbedConfig struct {
 	UpdatedAt                  time.Time `json:"updated_at,omitempty"`
 }
 
+// User represents a team member of a Statuspage organization
+type User struct {
+	ID             string `json:"id,omitempty"`
+	OrganizationID string `json:"organization_id,omitempty"`
+	Email          string `json:"email,omitempty"`
+	FirstName      string `json:"first_name,omitempty"`
+	LastName       string `json:"last_name,omitempty"`
+	CreatedAt      Time   `json:"created_at,omitempty"`
+	UpdatedAt      Time   `json:"updated_at,omitempty"`
+}
+
+// PagePermission describes a team member's access to one page. The role flags are only reported for pages
+// with Role Based Access Control.
+type PagePermission struct {
+	PageID             string `json:"page_id,omitempty"`
+	PageConfiguration  bool   `json:"page_configuration,omitempty"`
+	IncidentManager    bool   `json:"incident_manager,omitempty"`
+	MaintenanceManager bool   `json:"maintenance_manager,omitempty"`
+}
+
+// Permissions lists every page a team member can access
+type Permissions struct {
+	UserID string                    `json:"user_id,omitempty"`
+	Pages  OneOrMany[PagePermission] `json:"pages,omitempty"`
+}
+
 // ListOptions represents pagination parameters for API list requests
 type ListOptions struct {
 	Page    int `url:"page,omitempty"`
@@ -0,0 +1,76 @@
+package statuspage
+
+import "encoding/json"
+
+// Nullable is an input field with three states: unspecified, explicitly null, and set to a value, which may
+// be the zero value. It is a map so that omitempty leaves unspecified fields out of request bodies:
+//
+//   - the nil Nullable is unspecified and omitted
+//   - NewNull returns a Nullable encoded as JSON null, which clears the attribute
+//   - NewNullable returns a Nullable encoded as its value, even when that value is "", 0 or false
+type Nullable[T any] map[bool]T
+
+// NewNullable returns a Nullable set to v
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{true: v}
+}
+
+// NewNull returns a Nullable that is explicitly null
+func NewNull[T any]() Nullable[T] {
+	return Nullable[T]{false: *new(T)}
+}
+
+// Get returns the value and true when the Nullable is set to a value, or the zero value and false otherwise
+func (n Nullable[T]) Get() (T, bool) {
+	v, ok := n[true]
+	return v, ok
+}
+
+// Set sets the Nullable to v
+func (n *Nullable[T]) Set(v T) {
+	*n = NewNullable(v)
+}
+
+// SetNull makes the Nullable explicitly null
+func (n *Nullable[T]) SetNull() {
+	*n = NewNull[T]()
+}
+
+// SetUnspecified resets the Nullable so that it is omitted from requests
+func (n *Nullable[T]) SetUnspecified() {
+	*n = nil
+}
+
+// IsSpecified reports whether the Nullable is either null or set to a value
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// IsNull reports whether the Nullable is explicitly null
+func (n Nullable[T]) IsNull() bool {
+	_, ok := n[false]
+	return ok
+}
+
+// MarshalJSON encodes the value, or null when the Nullable is null or unspecified
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if v, ok := n.Get(); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON decodes null as an explicit null and anything else as a value
+func (n *Nullable[T]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		n.SetNull()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
@@ -0,0 +1,140 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OrganizationsService handles team members of a Statuspage organization and their page permissions
+type OrganizationsService struct {
+	client *Client
+}
+
+// UserRequest wraps user input data for API requests
+type UserRequest struct {
+	User *UserInput `json:"user"`
+}
+
+// UserInput contains the fields for inviting a new team member to an organization
+type UserInput struct {
+	Email     string `json:"email,omitempty"`
+	Password  string `json:"password,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+// PermissionsInput maps page IDs to the roles a user should hold on each page. Pages without Role Based
+// Access Control should map to an empty PageRoles. The user loses access to every page omitted from Pages.
+type PermissionsInput struct {
+	Pages map[string]*PageRoles `json:"pages"`
+}
+
+// PageRoles lists the roles granted on a page with Role Based Access Control
+type PageRoles struct {
+	PageConfiguration  Nullable[bool] `json:"page_configuration,omitempty"`
+	IncidentManager    Nullable[bool] `json:"incident_manager,omitempty"`
+	MaintenanceManager Nullable[bool] `json:"maintenance_manager,omitempty"`
+}
+
+// permissionsResponse is the envelope the API wraps around user permissions
+type permissionsResponse struct {
+	Data *Permissions `json:"data"`
+}
+
+// ListUsers retrieves a single page of team members in an organization
+func (s *OrganizationsService) ListUsers(ctx context.Context, organizationID string, opts *ListOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("organizations/%s/users", organizationID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	resp, err := s.client.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// ListAllUsers retrieves every team member in an organization by walking through all result pages
+func (s *OrganizationsService) ListAllUsers(ctx context.Context, organizationID string, opts *ListOptions) ([]*User, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*User, *Response, error) {
+		return s.ListUsers(ctx, organizationID, opts.forPage(page))
+	})
+}
+
+// CreateUser adds a team member to an organization
+func (s *OrganizationsService) CreateUser(ctx context.Context, organizationID string, user *UserInput) (*User, *Response, error) {
+	u := fmt.Sprintf("organizations/%s/users", organizationID)
+	userReq := &UserRequest{User: user}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, userReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newUser := new(User)
+	resp, err := s.client.Do(ctx, req, newUser)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newUser, resp, nil
+}
+
+// DeleteUser removes a team member from an organization, revoking all of their page access
+func (s *OrganizationsService) DeleteUser(ctx context.Context, organizationID, userID string) (*Response, error) {
+	u := fmt.Sprintf("organizations/%s/users/%s", organizationID, userID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetPermissions retrieves the pages a team member can access and the roles held on each
+func (s *OrganizationsService) GetPermissions(ctx context.Context, organizationID, userID string) (*Permissions, *Response, error) {
+	u := fmt.Sprintf("organizations/%s/permissions/%s", organizationID, userID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(permissionsResponse)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions.Data, resp, nil
+}
+
+// UpdatePermissions replaces the pages and roles granted to a team member
+func (s *OrganizationsService) UpdatePermissions(ctx context.Context, organizationID, userID string, permissions *PermissionsInput) (*Permissions, *Response, error) {
+	u := fmt.Sprintf("organizations/%s/permissions/%s", organizationID, userID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, permissions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(permissionsResponse)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated.Data, resp, nil
+}
@@ -0,0 +1,212 @@
+package statuspage_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+	"github.com/MinseokOh/statuspage-sdk-go/statuspagetest"
+)
+
+// newFakeClient starts a fake server with one page and returns a client for it
+func newFakeClient(t *testing.T) (*statuspagetest.Server, *statuspage.Client, string) {
+	t.Helper()
+	fake := statuspagetest.NewServer()
+	t.Cleanup(fake.Close)
+	page := fake.AddPage(statuspage.Page{Name: "Acme"})
+	client := statuspage.NewClient("test-key", statuspage.WithBaseURL(fake.URL+"/v1/"))
+	return fake, client, page.ID
+}
+
+// createComponents creates components with the given names and returns their IDs
+func createComponents(t *testing.T, client *statuspage.Client, pageID string, names ...string) []string {
+	t.Helper()
+	var ids []string
+	for _, name := range names {
+		component, _, err := client.Components.Create(context.Background(), pageID, &statuspage.ComponentInput{Name: name})
+		if err != nil {
+			t.Fatalf("Components.Create(%q): %v", name, err)
+		}
+		ids = append(ids, component.ID)
+	}
+	return ids
+}
+
+// accessStep is one membership change and the access list expected afterwards, as indexes into the created IDs
+type accessStep struct {
+	name string
+	call func(ids []string) ([]string, error)
+	want []int
+}
+
+func runAccessSteps(t *testing.T, ids []string, list func() ([]string, error), steps []accessStep) {
+	t.Helper()
+	for _, step := range steps {
+		got, err := step.call(ids)
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		want := []string{}
+		for _, i := range step.want {
+			want = append(want, ids[i])
+		}
+		if got == nil {
+			got = []string{}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: returned IDs = %v, want %v", step.name, got, want)
+		}
+
+		listed, err := list()
+		if err != nil {
+			t.Fatalf("%s: listing access: %v", step.name, err)
+		}
+		if !reflect.DeepEqual(listed, want) {
+			t.Errorf("%s: listed IDs = %v, want %v", step.name, listed, want)
+		}
+	}
+}
+
+func TestPageAccessUserComponents(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ctx := context.Background()
+	ids := createComponents(t, client, pageID, "API", "Web", "DB")
+	user, _, err := client.PageAccessUsers.Create(ctx, pageID, &statuspage.PageAccessUserInput{Email: "jo@example.com"})
+	if err != nil {
+		t.Fatalf("PageAccessUsers.Create: %v", err)
+	}
+
+	list := func() ([]string, error) {
+		components, _, err := client.PageAccessUsers.ListAllComponents(ctx, pageID, user.ID, nil)
+		listed := []string{}
+		for _, component := range components {
+			listed = append(listed, component.ID)
+		}
+		return listed, err
+	}
+	userComponents := func(user *statuspage.PageAccessUser, _ *statuspage.Response, err error) ([]string, error) {
+		if err != nil {
+			return nil, err
+		}
+		return user.ComponentIDs, nil
+	}
+
+	runAccessSteps(t, ids, list, []accessStep{
+		{"AddComponents", func(ids []string) ([]string, error) {
+			return userComponents(client.PageAccessUsers.AddComponents(ctx, pageID, user.ID, ids[:1]))
+		}, []int{0}},
+		{"AddComponents keeps existing access", func(ids []string) ([]string, error) {
+			return userComponents(client.PageAccessUsers.AddComponents(ctx, pageID, user.ID, ids[1:2]))
+		}, []int{0, 1}},
+		{"ReplaceComponents", func(ids []string) ([]string, error) {
+			return userComponents(client.PageAccessUsers.ReplaceComponents(ctx, pageID, user.ID, ids[2:]))
+		}, []int{2}},
+		{"AddComponents after replace", func(ids []string) ([]string, error) {
+			return userComponents(client.PageAccessUsers.AddComponents(ctx, pageID, user.ID, ids[:1]))
+		}, []int{2, 0}},
+		{"RemoveComponents", func(ids []string) ([]string, error) {
+			return userComponents(client.PageAccessUsers.RemoveComponents(ctx, pageID, user.ID, ids[2:]))
+		}, []int{0}},
+		{"RemoveAllComponents", func(ids []string) ([]string, error) {
+			return userComponents(client.PageAccessUsers.RemoveAllComponents(ctx, pageID, user.ID))
+		}, []int{}},
+	})
+
+	if _, _, err := client.PageAccessUsers.RemoveComponents(ctx, pageID, user.ID, nil); err == nil {
+		t.Error("RemoveComponents with no IDs: want error")
+	}
+}
+
+func TestPageAccessUserMetrics(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ctx := context.Background()
+	provider, _, err := client.MetricsProviders.Create(ctx, pageID, &statuspage.MetricsProviderInput{Type: statuspage.MetricsProviderTypeSelf})
+	if err != nil {
+		t.Fatalf("MetricsProviders.Create: %v", err)
+	}
+	var ids []string
+	for _, name := range []string{"Latency", "Errors"} {
+		metric, _, err := client.MetricsProviders.CreateMetric(ctx, pageID, provider.ID, &statuspage.ProviderMetricInput{
+			MetricInput: statuspage.MetricInput{Name: name},
+		})
+		if err != nil {
+			t.Fatalf("MetricsProviders.CreateMetric(%q): %v", name, err)
+		}
+		ids = append(ids, metric.ID)
+	}
+	user, _, err := client.PageAccessUsers.Create(ctx, pageID, &statuspage.PageAccessUserInput{Email: "jo@example.com"})
+	if err != nil {
+		t.Fatalf("PageAccessUsers.Create: %v", err)
+	}
+
+	list := func() ([]string, error) {
+		metrics, _, err := client.PageAccessUsers.ListAllMetrics(ctx, pageID, user.ID, nil)
+		listed := []string{}
+		for _, metric := range metrics {
+			listed = append(listed, metric.ID)
+		}
+		return listed, err
+	}
+	userMetrics := func(user *statuspage.PageAccessUser, _ *statuspage.Response, err error) ([]string, error) {
+		if err != nil {
+			return nil, err
+		}
+		return user.MetricIDs, nil
+	}
+
+	runAccessSteps(t, ids, list, []accessStep{
+		{"AddMetrics", func(ids []string) ([]string, error) {
+			return userMetrics(client.PageAccessUsers.AddMetrics(ctx, pageID, user.ID, ids[:1]))
+		}, []int{0}},
+		{"AddMetrics keeps existing access", func(ids []string) ([]string, error) {
+			return userMetrics(client.PageAccessUsers.AddMetrics(ctx, pageID, user.ID, ids[1:]))
+		}, []int{0, 1}},
+		{"ReplaceMetrics", func(ids []string) ([]string, error) {
+			return userMetrics(client.PageAccessUsers.ReplaceMetrics(ctx, pageID, user.ID, ids[1:]))
+		}, []int{1}},
+		{"RemoveAllMetrics", func(ids []string) ([]string, error) {
+			return userMetrics(client.PageAccessUsers.RemoveAllMetrics(ctx, pageID, user.ID))
+		}, []int{}},
+	})
+}
+
+func TestPageAccessGroupComponents(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ctx := context.Background()
+	ids := createComponents(t, client, pageID, "API", "Web", "DB")
+	group, _, err := client.PageAccessGroups.Create(ctx, pageID, &statuspage.PageAccessGroupInput{Name: "Partners"})
+	if err != nil {
+		t.Fatalf("PageAccessGroups.Create: %v", err)
+	}
+
+	list := func() ([]string, error) {
+		components, _, err := client.PageAccessGroups.ListAllComponents(ctx, pageID, group.ID, nil)
+		listed := []string{}
+		for _, component := range components {
+			listed = append(listed, component.ID)
+		}
+		return listed, err
+	}
+	groupComponents := func(group *statuspage.PageAccessGroup, _ *statuspage.Response, err error) ([]string, error) {
+		if err != nil {
+			return nil, err
+		}
+		return group.ComponentIDs, nil
+	}
+
+	runAccessSteps(t, ids, list, []accessStep{
+		{"AddComponents", func(ids []string) ([]string, error) {
+			return groupComponents(client.PageAccessGroups.AddComponents(ctx, pageID, group.ID, ids[:2]))
+		}, []int{0, 1}},
+		{"AddComponents keeps existing access", func(ids []string) ([]string, error) {
+			return groupComponents(client.PageAccessGroups.AddComponents(ctx, pageID, group.ID, ids[2:]))
+		}, []int{0, 1, 2}},
+		{"ReplaceComponents", func(ids []string) ([]string, error) {
+			return groupComponents(client.PageAccessGroups.ReplaceComponents(ctx, pageID, group.ID, ids[1:2]))
+		}, []int{1}},
+		{"RemoveComponent", func(ids []string) ([]string, error) {
+			return groupComponents(client.PageAccessGroups.RemoveComponent(ctx, pageID, group.ID, ids[1]))
+		}, []int{}},
+	})
+}
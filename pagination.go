@@ -0,0 +1,77 @@
+package statuspage
+
+import "context"
+
+// walkPages requests successive pages starting at startPage and passes every item to fn until an empty
+// page is returned or the response reports that no further page exists
+func walkPages[T any](ctx context.Context, startPage int, fetch func(page int) ([]T, *Response, error), fn func(T) error) (*Response, error) {
+	if startPage < 1 {
+		startPage = 1
+	}
+
+	var lastResp *Response
+	page := startPage
+	for {
+		if err := ctx.Err(); err != nil {
+			return lastResp, err
+		}
+
+		items, resp, err := fetch(page)
+		if resp != nil {
+			lastResp = resp
+		}
+		if err != nil {
+			return lastResp, err
+		}
+		if len(items) == 0 {
+			return lastResp, nil
+		}
+
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return lastResp, err
+			}
+		}
+
+		switch {
+		case resp != nil && resp.NextPage > page:
+			page = resp.NextPage
+		case resp != nil && resp.isLastPage(page):
+			return lastResp, nil
+		default:
+			page++
+		}
+	}
+}
+
+// collectPages gathers every item from every page into a single slice
+func collectPages[T any](ctx context.Context, startPage int, fetch func(page int) ([]T, *Response, error)) ([]T, *Response, error) {
+	var all []T
+	resp, err := walkPages(ctx, startPage, fetch, func(item T) error {
+		all = append(all, item)
+		return nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return all, resp, nil
+}
+
+// startPage returns the first page to request for the given pagination options
+func (o *ListOptions) startPage() int {
+	if o == nil {
+		return 1
+	}
+	return o.Page
+}
+
+// forPage copies the pagination options with the page number replaced
+func (o *ListOptions) forPage(page int) *ListOptions {
+	opts := ListOptions{}
+	if o != nil {
+		opts = *o
+	}
+	opts.Page = page
+	return &opts
+}
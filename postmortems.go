@@ -0,0 +1,123 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PostmortemsService handles drafting, publishing and reverting incident postmortems
+type PostmortemsService struct {
+	client *Client
+}
+
+// PostmortemRequest wraps postmortem draft data for API requests
+type PostmortemRequest struct {
+	Postmortem *PostmortemInput `json:"postmortem"`
+}
+
+// PostmortemInput contains the draft body of a postmortem
+type PostmortemInput struct {
+	BodyDraft string `json:"body_draft"`
+}
+
+// PostmortemPublishRequest wraps postmortem publishing options for API requests
+type PostmortemPublishRequest struct {
+	Postmortem *PostmortemPublishInput `json:"postmortem"`
+}
+
+// PostmortemPublishInput controls who is notified when a postmortem is published
+type PostmortemPublishInput struct {
+	NotifyTwitter     Nullable[bool] `json:"notify_twitter,omitempty"`
+	NotifySubscribers Nullable[bool] `json:"notify_subscribers,omitempty"`
+	CustomTweet       string         `json:"custom_tweet,omitempty"`
+}
+
+// Get retrieves the postmortem of an incident, including any unpublished draft
+func (s *PostmortemsService) Get(ctx context.Context, pageID, incidentID string) (*Postmortem, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/postmortem", pageID, incidentID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	postmortem := new(Postmortem)
+	resp, err := s.client.Do(ctx, req, postmortem)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return postmortem, resp, nil
+}
+
+// Draft creates or replaces the draft body of an incident postmortem without publishing it
+func (s *PostmortemsService) Draft(ctx context.Context, pageID, incidentID string, postmortem *PostmortemInput) (*Postmortem, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/postmortem", pageID, incidentID)
+	postmortemReq := &PostmortemRequest{Postmortem: postmortem}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, postmortemReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	draft := new(Postmortem)
+	resp, err := s.client.Do(ctx, req, draft)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return draft, resp, nil
+}
+
+// Publish makes the current postmortem draft public, optionally notifying subscribers and Twitter followers
+func (s *PostmortemsService) Publish(ctx context.Context, pageID, incidentID string, opts *PostmortemPublishInput) (*Postmortem, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/postmortem/publish", pageID, incidentID)
+	if opts == nil {
+		opts = &PostmortemPublishInput{}
+	}
+	publishReq := &PostmortemPublishRequest{Postmortem: opts}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, publishReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	published := new(Postmortem)
+	resp, err := s.client.Do(ctx, req, published)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return published, resp, nil
+}
+
+// Revert unpublishes a postmortem, returning it to draft state
+func (s *PostmortemsService) Revert(ctx context.Context, pageID, incidentID string) (*Postmortem, *Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/postmortem/revert", pageID, incidentID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reverted := new(Postmortem)
+	resp, err := s.client.Do(ctx, req, reverted)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reverted, resp, nil
+}
+
+// Delete removes the postmortem draft of an incident
+func (s *PostmortemsService) Delete(ctx context.Context, pageID, incidentID string) (*Response, error) {
+	u := fmt.Sprintf("pages/%s/incidents/%s/postmortem", pageID, incidentID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
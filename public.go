@@ -0,0 +1,167 @@
+package statuspage
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Status indicator values reported by the public status API
+const (
+	StatusIndicatorNone        = "none"
+	StatusIndicatorMinor       = "minor"
+	StatusIndicatorMajor       = "major"
+	StatusIndicatorCritical    = "critical"
+	StatusIndicatorMaintenance = "maintenance"
+)
+
+// PublicClient reads the unauthenticated status API that every Statuspage-hosted page serves under
+// /api/v2, such as https://www.githubstatus.com/api/v2/summary.json. It needs no API key, so it can
+// monitor status pages owned by other organizations.
+type PublicClient struct {
+	client *Client
+}
+
+// PublicPage identifies the status page that produced a public API response
+type PublicPage struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	URL       string `json:"url,omitempty"`
+	TimeZone  string `json:"time_zone,omitempty"`
+	UpdatedAt Time   `json:"updated_at,omitempty"`
+}
+
+// PublicStatus is the rollup of a page's overall status
+type PublicStatus struct {
+	Indicator   string `json:"indicator,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// PublicSummary is a page's status, components, unresolved incidents and upcoming or in-progress
+// maintenances, as returned by summary.json
+type PublicSummary struct {
+	Page                  PublicPage   `json:"page"`
+	Status                PublicStatus `json:"status"`
+	Components            []*Component `json:"components"`
+	Incidents             []*Incident  `json:"incidents"`
+	ScheduledMaintenances []*Incident  `json:"scheduled_maintenances"`
+}
+
+// PublicStatusResponse is the body of status.json
+type PublicStatusResponse struct {
+	Page   PublicPage   `json:"page"`
+	Status PublicStatus `json:"status"`
+}
+
+// publicComponentsResponse is the body of components.json
+type publicComponentsResponse struct {
+	Components []*Component `json:"components"`
+}
+
+// publicIncidentsResponse is the body of the incident listings
+type publicIncidentsResponse struct {
+	Incidents []*Incident `json:"incidents"`
+}
+
+// publicMaintenancesResponse is the body of the scheduled maintenance listings
+type publicMaintenancesResponse struct {
+	ScheduledMaintenances []*Incident `json:"scheduled_maintenances"`
+}
+
+// NewPublicClient creates a client for the public status API of the page hosted at pageURL, for example
+// "https://www.githubstatus.com". Client options such as WithHTTPClient, WithRetryOptions and WithRateLimit
+// apply as they do to NewClient.
+func NewPublicClient(pageURL string, opts ...ClientOption) *PublicClient {
+	baseURL := strings.TrimRight(pageURL, "/") + "/api/v2/"
+	return &PublicClient{
+		client: NewClient("", append([]ClientOption{WithBaseURL(baseURL)}, opts...)...),
+	}
+}
+
+// Summary retrieves the page status together with its components, unresolved incidents and maintenances
+func (c *PublicClient) Summary(ctx context.Context) (*PublicSummary, *Response, error) {
+	summary := new(PublicSummary)
+	resp, err := c.get(ctx, "summary.json", summary)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return summary, resp, nil
+}
+
+// Status retrieves the overall status indicator of the page
+func (c *PublicClient) Status(ctx context.Context) (*PublicStatusResponse, *Response, error) {
+	status := new(PublicStatusResponse)
+	resp, err := c.get(ctx, "status.json", status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// Components retrieves every component of the page, including component groups
+func (c *PublicClient) Components(ctx context.Context) ([]*Component, *Response, error) {
+	body := new(publicComponentsResponse)
+	resp, err := c.get(ctx, "components.json", body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return body.Components, resp, nil
+}
+
+// Incidents retrieves the most recent incidents of the page, resolved or not
+func (c *PublicClient) Incidents(ctx context.Context) ([]*Incident, *Response, error) {
+	return c.incidents(ctx, "incidents.json")
+}
+
+// UnresolvedIncidents retrieves the incidents of the page that have not been resolved
+func (c *PublicClient) UnresolvedIncidents(ctx context.Context) ([]*Incident, *Response, error) {
+	return c.incidents(ctx, "incidents/unresolved.json")
+}
+
+// ScheduledMaintenances retrieves the most recent scheduled maintenances of the page
+func (c *PublicClient) ScheduledMaintenances(ctx context.Context) ([]*Incident, *Response, error) {
+	return c.maintenances(ctx, "scheduled-maintenances.json")
+}
+
+// UpcomingMaintenances retrieves the scheduled maintenances of the page that have not started
+func (c *PublicClient) UpcomingMaintenances(ctx context.Context) ([]*Incident, *Response, error) {
+	return c.maintenances(ctx, "scheduled-maintenances/upcoming.json")
+}
+
+// ActiveMaintenances retrieves the scheduled maintenances of the page that are in progress or being verified
+func (c *PublicClient) ActiveMaintenances(ctx context.Context) ([]*Incident, *Response, error) {
+	return c.maintenances(ctx, "scheduled-maintenances/active.json")
+}
+
+func (c *PublicClient) incidents(ctx context.Context, path string) ([]*Incident, *Response, error) {
+	body := new(publicIncidentsResponse)
+	resp, err := c.get(ctx, path, body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return body.Incidents, resp, nil
+}
+
+func (c *PublicClient) maintenances(ctx context.Context, path string) ([]*Incident, *Response, error) {
+	body := new(publicMaintenancesResponse)
+	resp, err := c.get(ctx, path, body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return body.ScheduledMaintenances, resp, nil
+}
+
+// get requests a public API document and decodes it into v
+func (c *PublicClient) get(ctx context.Context, path string, v interface{}) (*Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(ctx, req, v)
+}
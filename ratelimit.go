@@ -0,0 +1,75 @@
+package statuspage
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerSecond is the request rate Statuspage allows per API key
+const DefaultRequestsPerSecond = 1
+
+// RateLimiter blocks outgoing requests until they are allowed to proceed
+type RateLimiter interface {
+	// Wait blocks until a request may be sent or the context is done
+	Wait(ctx context.Context) error
+}
+
+// TokenBucketLimiter is a RateLimiter that refills tokens at a fixed rate up to a maximum burst size.
+// It is safe for concurrent use, so a single limiter can be shared by every service and goroutine.
+type TokenBucketLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucketLimiter creates a limiter allowing requestsPerSecond on average with bursts of up to burst requests.
+// A non-positive rate disables limiting.
+func NewTokenBucketLimiter(requestsPerSecond float64, burst int) *TokenBucketLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucketLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait reserves a token and blocks until it becomes available, returning the token if ctx is done first
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	deficit := -l.tokens
+	l.mu.Unlock()
+
+	if deficit <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(time.Duration(deficit / l.rate * float64(time.Second)))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
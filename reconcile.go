@@ -0,0 +1,695 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ChangeAction is what a planned change does to a resource
+type ChangeAction string
+
+// Planned change actions
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+// Kinds of resources managed by a Reconciler
+const (
+	ResourceComponent         = "component"
+	ResourceComponentGroup    = "component_group"
+	ResourceTemplate          = "template"
+	ResourceMetric            = "metric"
+	ResourceStatusEmbedConfig = "status_embed_config"
+)
+
+// FieldChange is a single attribute changed by a planned change. Old is nil for creations.
+type FieldChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// Change is one create, update or delete in a Plan. ID is the live resource ID for updates and deletions.
+type Change struct {
+	Action   ChangeAction
+	Resource string
+	Name     string
+	ID       string
+	Fields   []FieldChange
+
+	apply func(ctx context.Context) error
+}
+
+// String describes the change on a single line, such as `~ component "API" (rb5wq1dczvbm)`
+func (c *Change) String() string {
+	symbol := map[ChangeAction]string{ChangeCreate: "+", ChangeUpdate: "~", ChangeDelete: "-"}[c.Action]
+	line := fmt.Sprintf("%s %s %q", symbol, c.Resource, c.Name)
+	if c.ID != "" {
+		line += " (" + c.ID + ")"
+	}
+	return line
+}
+
+// Plan is the ordered list of changes that bring a page in line with a PageSpec. Components and groups are
+// created and updated first, so that groups can refer to new components, and deleted groups are removed
+// before deleted components. Warnings lists differences the API offers no way to resolve, such as changed
+// incident templates.
+type Plan struct {
+	PageID   string
+	Changes  []*Change
+	Warnings []string
+
+	// componentIDs resolves component names to IDs, including components created while applying the plan
+	componentIDs map[string]string
+}
+
+// Empty reports whether the plan has no changes to apply. Warnings may still be present.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String renders the plan as a diff, one change per line followed by the fields it sets, and then the warnings
+func (p *Plan) String() string {
+	if p.Empty() && len(p.Warnings) == 0 {
+		return fmt.Sprintf("Page %s matches the spec, no changes.\n", p.PageID)
+	}
+
+	counts := map[ChangeAction]int{}
+	for _, change := range p.Changes {
+		counts[change.Action]++
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Plan for page %s: %d to create, %d to update, %d to delete\n",
+		p.PageID, counts[ChangeCreate], counts[ChangeUpdate], counts[ChangeDelete])
+	for _, change := range p.Changes {
+		b.WriteString(change.String())
+		b.WriteString("\n")
+		for _, field := range change.Fields {
+			if change.Action == ChangeCreate {
+				fmt.Fprintf(&b, "    %s: %s\n", field.Field, formatFieldValue(field.New))
+			} else {
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", field.Field, formatFieldValue(field.Old), formatFieldValue(field.New))
+			}
+		}
+	}
+	for _, warning := range p.Warnings {
+		fmt.Fprintf(&b, "! %s\n", warning)
+	}
+	return b.String()
+}
+
+// ReconcilerOption is a functional option for configuring a Reconciler
+type ReconcilerOption func(*Reconciler)
+
+// WithDryRun makes Apply and Reconcile write the plan to w instead of changing the page
+func WithDryRun(w io.Writer) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.dryRun = true
+		r.out = w
+	}
+}
+
+// Reconciler brings the components, component groups, incident templates, metrics and status embed config
+// of a page in line with a PageSpec, using the regular services of the client. Incident templates can only
+// be created through the API, so changed and undeclared templates are reported as plan warnings instead.
+type Reconciler struct {
+	client *Client
+	pageID string
+	dryRun bool
+	out    io.Writer
+}
+
+// NewReconciler creates a reconciler for the page identified by pageID
+func NewReconciler(client *Client, pageID string, opts ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		client: client,
+		pageID: pageID,
+		out:    io.Discard,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Reconcile plans the changes needed to match spec and applies them, or only prints them in dry-run mode
+func (r *Reconciler) Reconcile(ctx context.Context, spec *PageSpec) (*Plan, error) {
+	plan, err := r.Plan(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, r.Apply(ctx, plan)
+}
+
+// Plan reads the live state of the page and computes the changes needed to match spec without applying them
+func (r *Reconciler) Plan(ctx context.Context, spec *PageSpec) (*Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{PageID: r.pageID, componentIDs: map[string]string{}}
+
+	components, _, err := r.client.Components.ListAll(ctx, r.pageID, nil)
+	if err != nil {
+		return nil, err
+	}
+	var liveComponents []*Component
+	componentNames := map[string]string{}
+	for _, component := range components {
+		if component.Group {
+			continue
+		}
+		liveComponents = append(liveComponents, component)
+		componentNames[component.ID] = component.Name
+		if _, ok := plan.componentIDs[component.Name]; !ok {
+			plan.componentIDs[component.Name] = component.ID
+		}
+	}
+
+	groups, _, err := r.client.ComponentGroups.ListAll(ctx, r.pageID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r.planComponents(plan, spec.Components, liveComponents)
+	deleteGroups := r.planComponentGroups(plan, spec.ComponentGroups, groups, componentNames)
+	plan.Changes = append(plan.Changes, deleteGroups...)
+	if spec.Components != nil {
+		plan.Changes = append(plan.Changes, deleteUndeclared(plan, ResourceComponent, liveComponents,
+			func(component *Component) (string, string) { return component.ID, component.Name },
+			func(ctx context.Context, id string) error {
+				_, err := r.client.Components.Delete(ctx, r.pageID, id)
+				return err
+			}, declaredNames(spec.Components, func(s ComponentSpec) string { return s.Name }))...)
+	}
+
+	if spec.Templates != nil {
+		templates, _, err := r.client.Templates.ListAll(ctx, r.pageID, nil)
+		if err != nil {
+			return nil, err
+		}
+		r.planTemplates(plan, spec.Templates, templates)
+	}
+
+	if spec.Metrics != nil {
+		metrics, _, err := r.client.Metrics.ListAll(ctx, r.pageID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.planMetrics(plan, spec.Metrics, metrics); err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.StatusEmbedConfig != nil {
+		config, _, err := r.client.StatusEmbedConfig.Get(ctx, r.pageID)
+		if err != nil {
+			return nil, err
+		}
+		r.planStatusEmbedConfig(plan, spec.StatusEmbedConfig, config)
+	}
+
+	return plan, nil
+}
+
+// Apply makes the changes of a plan in order, stopping at the first failure. In dry-run mode it writes the
+// plan instead.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) error {
+	if r.dryRun {
+		_, err := io.WriteString(r.out, plan.String())
+		return err
+	}
+
+	for _, change := range plan.Changes {
+		if err := change.apply(ctx); err != nil {
+			return fmt.Errorf("statuspage: applying %s: %w", change, err)
+		}
+	}
+	return nil
+}
+
+// planComponents adds the creations and updates needed for the declared components
+func (r *Reconciler) planComponents(plan *Plan, specs []ComponentSpec, live []*Component) {
+	byName := map[string]*Component{}
+	for _, component := range live {
+		if _, ok := byName[component.Name]; !ok {
+			byName[component.Name] = component
+		}
+	}
+
+	for _, spec := range specs {
+		spec := spec
+		current, ok := byName[spec.Name]
+		if !ok {
+			input := &ComponentInput{
+				Name:               spec.Name,
+				OnlyShowIfDegraded: NewNullable(spec.OnlyShowIfDegraded),
+				Showcase:           NewNullable(spec.Showcase),
+			}
+			if spec.Description != "" {
+				input.Description = NewNullable(spec.Description)
+			}
+			fields := createFields(
+				"description", spec.Description,
+				"only_show_if_degraded", spec.OnlyShowIfDegraded,
+				"showcase", spec.Showcase,
+			)
+			plan.Changes = append(plan.Changes, &Change{
+				Action: ChangeCreate, Resource: ResourceComponent, Name: spec.Name, Fields: fields,
+				apply: func(ctx context.Context) error {
+					component, _, err := r.client.Components.Create(ctx, r.pageID, input)
+					if err != nil {
+						return err
+					}
+					plan.componentIDs[spec.Name] = component.ID
+					return nil
+				},
+			})
+			continue
+		}
+
+		var fields []FieldChange
+		input := &ComponentInput{}
+		if diffField(&fields, "description", current.Description, spec.Description) {
+			input.Description = NewNullable(spec.Description)
+		}
+		if diffField(&fields, "only_show_if_degraded", current.OnlyShowIfDegraded, spec.OnlyShowIfDegraded) {
+			input.OnlyShowIfDegraded = NewNullable(spec.OnlyShowIfDegraded)
+		}
+		if diffField(&fields, "showcase", current.Showcase, spec.Showcase) {
+			input.Showcase = NewNullable(spec.Showcase)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		id := current.ID
+		plan.Changes = append(plan.Changes, &Change{
+			Action: ChangeUpdate, Resource: ResourceComponent, Name: spec.Name, ID: id, Fields: fields,
+			apply: func(ctx context.Context) error {
+				_, _, err := r.client.Components.Update(ctx, r.pageID, id, input)
+				return err
+			},
+		})
+	}
+}
+
+// planComponentGroups adds the creations and updates needed for the declared groups and returns the
+// deletions separately, since they must run before components are deleted
+func (r *Reconciler) planComponentGroups(plan *Plan, specs []ComponentGroupSpec, live []*ComponentGroup, componentNames map[string]string) []*Change {
+	byName := map[string]*ComponentGroup{}
+	for _, group := range live {
+		if _, ok := byName[group.Name]; !ok {
+			byName[group.Name] = group
+		}
+	}
+
+	for _, spec := range specs {
+		spec := spec
+		current, ok := byName[spec.Name]
+		if !ok {
+			input := &ComponentGroupInput{Name: spec.Name}
+			if spec.Description != "" {
+				input.Description = NewNullable(spec.Description)
+			}
+			plan.Changes = append(plan.Changes, &Change{
+				Action: ChangeCreate, Resource: ResourceComponentGroup, Name: spec.Name,
+				Fields: createFields("description", spec.Description, "components", spec.Components),
+				apply: func(ctx context.Context) error {
+					members, err := plan.resolveComponents(spec.Components)
+					if err != nil {
+						return err
+					}
+					input.Components = members
+					_, _, err = r.client.ComponentGroups.Create(ctx, r.pageID, input)
+					return err
+				},
+			})
+			continue
+		}
+
+		var members []string
+		for _, id := range current.Components {
+			name, ok := componentNames[id]
+			if !ok {
+				name = id
+			}
+			members = append(members, name)
+		}
+
+		var fields []FieldChange
+		input := &ComponentGroupInput{}
+		if diffField(&fields, "description", current.Description, spec.Description) {
+			input.Description = NewNullable(spec.Description)
+		}
+		membersChanged := !equalStrings(members, spec.Components)
+		if membersChanged {
+			fields = append(fields, FieldChange{Field: "components", Old: members, New: spec.Components})
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		id := current.ID
+		plan.Changes = append(plan.Changes, &Change{
+			Action: ChangeUpdate, Resource: ResourceComponentGroup, Name: spec.Name, ID: id, Fields: fields,
+			apply: func(ctx context.Context) error {
+				if membersChanged {
+					ids, err := plan.resolveComponents(spec.Components)
+					if err != nil {
+						return err
+					}
+					input.Components = ids
+				}
+				_, _, err := r.client.ComponentGroups.Update(ctx, r.pageID, id, input)
+				return err
+			},
+		})
+	}
+
+	if specs == nil {
+		return nil
+	}
+	return deleteUndeclared(plan, ResourceComponentGroup, live,
+		func(group *ComponentGroup) (string, string) { return group.ID, group.Name },
+		func(ctx context.Context, id string) error {
+			_, err := r.client.ComponentGroups.Delete(ctx, r.pageID, id)
+			return err
+		}, declaredNames(specs, func(s ComponentGroupSpec) string { return s.Name }))
+}
+
+// planTemplates adds creations for the declared incident templates that do not exist. The API cannot update
+// or delete templates, so differences in existing templates and undeclared templates become warnings.
+func (r *Reconciler) planTemplates(plan *Plan, specs []TemplateSpec, live []*Template) {
+	byName := map[string]*Template{}
+	for _, template := range live {
+		if _, ok := byName[template.Name]; !ok {
+			byName[template.Name] = template
+		}
+	}
+
+	for _, spec := range specs {
+		spec := spec
+		current, ok := byName[spec.Name]
+		if !ok {
+			input := &TemplateInput{
+				Name:                    spec.Name,
+				Body:                    spec.Body,
+				ShouldTweet:             NewNullable(spec.ShouldTweet),
+				ShouldSendNotifications: NewNullable(spec.ShouldSendNotifications),
+			}
+			if spec.UpdateStatus != "" {
+				input.UpdateStatus = NewNullable(spec.UpdateStatus)
+			}
+			plan.Changes = append(plan.Changes, &Change{
+				Action: ChangeCreate, Resource: ResourceTemplate, Name: spec.Name,
+				Fields: createFields(
+					"body", spec.Body,
+					"update_status", spec.UpdateStatus,
+					"should_tweet", spec.ShouldTweet,
+					"should_send_notifications", spec.ShouldSendNotifications,
+				),
+				apply: func(ctx context.Context) error {
+					_, _, err := r.client.Templates.Create(ctx, r.pageID, input)
+					return err
+				},
+			})
+			continue
+		}
+
+		var fields []FieldChange
+		diffField(&fields, "body", current.Body, spec.Body)
+		if spec.UpdateStatus != "" {
+			diffField(&fields, "update_status", current.UpdateStatus, spec.UpdateStatus)
+		}
+		diffField(&fields, "should_tweet", current.ShouldTweet, spec.ShouldTweet)
+		diffField(&fields, "should_send_notifications", current.ShouldSendNotifications, spec.ShouldSendNotifications)
+		if len(fields) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(fields))
+		for _, field := range fields {
+			names = append(names, field.Field)
+		}
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("%s %q (%s) differs in %s, but incident templates cannot be updated through the API",
+			ResourceTemplate, spec.Name, current.ID, strings.Join(names, ", ")))
+	}
+
+	for _, change := range deleteUndeclared(plan, ResourceTemplate, live,
+		func(template *Template) (string, string) { return template.ID, template.Name },
+		nil, declaredNames(specs, func(s TemplateSpec) string { return s.Name })) {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("%s %q (%s) is not declared, but incident templates cannot be deleted through the API",
+			ResourceTemplate, change.Name, change.ID))
+	}
+}
+
+// planMetrics adds the changes needed for the declared metrics. New metrics are created through their
+// metrics provider, so it fails when a missing metric has no ProviderID.
+func (r *Reconciler) planMetrics(plan *Plan, specs []MetricSpec, live []*Metric) error {
+	byName := map[string]*Metric{}
+	for _, metric := range live {
+		if _, ok := byName[metric.Name]; !ok {
+			byName[metric.Name] = metric
+		}
+	}
+
+	for _, spec := range specs {
+		spec := spec
+		current, ok := byName[spec.Name]
+		if !ok {
+			if spec.ProviderID == "" {
+				return fmt.Errorf("statuspage: metric %q does not exist and has no metrics provider to create it under", spec.Name)
+			}
+			input := &ProviderMetricInput{MetricInput: MetricInput{
+				Name:          spec.Name,
+				YAxisHidden:   NewNullable(spec.YAxisHidden),
+				DecimalPlaces: NewNullable(spec.DecimalPlaces),
+			}}
+			if spec.DisplayName != "" {
+				input.DisplayName = NewNullable(spec.DisplayName)
+			}
+			if spec.Suffix != "" {
+				input.Suffix = NewNullable(spec.Suffix)
+			}
+			if spec.Tooltip != "" {
+				input.Tooltip = NewNullable(spec.Tooltip)
+			}
+			if spec.YAxisMin != nil {
+				input.YAxisMin = NewNullable(*spec.YAxisMin)
+			}
+			if spec.YAxisMax != nil {
+				input.YAxisMax = NewNullable(*spec.YAxisMax)
+			}
+			plan.Changes = append(plan.Changes, &Change{
+				Action: ChangeCreate, Resource: ResourceMetric, Name: spec.Name,
+				Fields: createFields(
+					"metrics_provider_id", spec.ProviderID,
+					"display_name", spec.DisplayName,
+					"suffix", spec.Suffix,
+					"tooltip", spec.Tooltip,
+					"decimal_places", spec.DecimalPlaces,
+					"y_axis_min", spec.YAxisMin,
+					"y_axis_max", spec.YAxisMax,
+					"y_axis_hidden", spec.YAxisHidden,
+				),
+				apply: func(ctx context.Context) error {
+					_, _, err := r.client.MetricsProviders.CreateMetric(ctx, r.pageID, spec.ProviderID, input)
+					return err
+				},
+			})
+			continue
+		}
+
+		var fields []FieldChange
+		input := &MetricInput{}
+		if diffField(&fields, "display_name", current.DisplayName, spec.DisplayName) {
+			input.DisplayName = NewNullable(spec.DisplayName)
+		}
+		if diffField(&fields, "suffix", current.Suffix, spec.Suffix) {
+			input.Suffix = NewNullable(spec.Suffix)
+		}
+		if diffField(&fields, "tooltip", current.Tooltip, spec.Tooltip) {
+			input.Tooltip = NewNullable(spec.Tooltip)
+		}
+		if diffField(&fields, "decimal_places", current.DecimalPlaces, spec.DecimalPlaces) {
+			input.DecimalPlaces = NewNullable(spec.DecimalPlaces)
+		}
+		if spec.YAxisMin != nil && diffField(&fields, "y_axis_min", current.YAxisMin, *spec.YAxisMin) {
+			input.YAxisMin = NewNullable(*spec.YAxisMin)
+		}
+		if spec.YAxisMax != nil && diffField(&fields, "y_axis_max", current.YAxisMax, *spec.YAxisMax) {
+			input.YAxisMax = NewNullable(*spec.YAxisMax)
+		}
+		if diffField(&fields, "y_axis_hidden", current.YAxisHidden, spec.YAxisHidden) {
+			input.YAxisHidden = NewNullable(spec.YAxisHidden)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		id := current.ID
+		plan.Changes = append(plan.Changes, &Change{
+			Action: ChangeUpdate, Resource: ResourceMetric, Name: spec.Name, ID: id, Fields: fields,
+			apply: func(ctx context.Context) error {
+				_, _, err := r.client.Metrics.Update(ctx, r.pageID, id, input)
+				return err
+			},
+		})
+	}
+
+	plan.Changes = append(plan.Changes, deleteUndeclared(plan, ResourceMetric, live,
+		func(metric *Metric) (string, string) { return metric.ID, metric.Name },
+		func(ctx context.Context, id string) error {
+			_, err := r.client.Metrics.Delete(ctx, r.pageID, id)
+			return err
+		}, declaredNames(specs, func(s MetricSpec) string { return s.Name }))...)
+	return nil
+}
+
+// planStatusEmbedConfig adds an update for the status embed settings that differ from the spec
+func (r *Reconciler) planStatusEmbedConfig(plan *Plan, spec *StatusEmbedConfigSpec, current *StatusEmbedConfig) {
+	var fields []FieldChange
+	input := &StatusEmbedConfigInput{}
+	if spec.Position != "" && diffField(&fields, "position", current.Position, spec.Position) {
+		input.Position = spec.Position
+	}
+	if spec.IncidentBackgroundColor != "" && diffField(&fields, "incident_background_color", current.IncidentBackgroundColor, spec.IncidentBackgroundColor) {
+		input.IncidentBackgroundColor = NewNullable(spec.IncidentBackgroundColor)
+	}
+	if spec.IncidentTextColor != "" && diffField(&fields, "incident_text_color", current.IncidentTextColor, spec.IncidentTextColor) {
+		input.IncidentTextColor = NewNullable(spec.IncidentTextColor)
+	}
+	if spec.MaintenanceBackgroundColor != "" && diffField(&fields, "maintenance_background_color", current.MaintenanceBackgroundColor, spec.MaintenanceBackgroundColor) {
+		input.MaintenanceBackgroundColor = NewNullable(spec.MaintenanceBackgroundColor)
+	}
+	if spec.MaintenanceTextColor != "" && diffField(&fields, "maintenance_text_color", current.MaintenanceTextColor, spec.MaintenanceTextColor) {
+		input.MaintenanceTextColor = NewNullable(spec.MaintenanceTextColor)
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	plan.Changes = append(plan.Changes, &Change{
+		Action: ChangeUpdate, Resource: ResourceStatusEmbedConfig, Name: plan.PageID, Fields: fields,
+		apply: func(ctx context.Context) error {
+			_, _, err := r.client.StatusEmbedConfig.Update(ctx, r.pageID, input)
+			return err
+		},
+	})
+}
+
+// resolveComponents converts component names to IDs, including components created earlier in the plan
+func (p *Plan) resolveComponents(names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := p.componentIDs[name]
+		if !ok {
+			return nil, fmt.Errorf("component %q does not exist", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// deleteUndeclared returns a deletion for every live resource whose name is not declared. Live resources that
+// share a declared name beyond the first are deleted as well.
+func deleteUndeclared[T any](plan *Plan, resource string, live []*T, identify func(*T) (id, name string), remove func(ctx context.Context, id string) error, declared map[string]bool) []*Change {
+	var changes []*Change
+	kept := map[string]bool{}
+	for _, item := range live {
+		id, name := identify(item)
+		if declared[name] && !kept[name] {
+			kept[name] = true
+			continue
+		}
+
+		changes = append(changes, &Change{
+			Action: ChangeDelete, Resource: resource, Name: name, ID: id,
+			apply: func(ctx context.Context) error {
+				return remove(ctx, id)
+			},
+		})
+	}
+	return changes
+}
+
+// declaredNames returns the set of names declared in a spec list
+func declaredNames[T any](specs []T, name func(T) string) map[string]bool {
+	names := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		names[name(spec)] = true
+	}
+	return names
+}
+
+// diffField records a field change when the live and declared values differ and reports whether they did
+func diffField[T comparable](fields *[]FieldChange, field string, old, new T) bool {
+	if old == new {
+		return false
+	}
+	*fields = append(*fields, FieldChange{Field: field, Old: old, New: new})
+	return true
+}
+
+// createFields lists the non-zero values of a resource being created, given as alternating names and values
+func createFields(pairs ...interface{}) []FieldChange {
+	var fields []FieldChange
+	for i := 0; i+1 < len(pairs); i += 2 {
+		switch v := pairs[i+1].(type) {
+		case string:
+			if v == "" {
+				continue
+			}
+		case bool:
+			if !v {
+				continue
+			}
+		case int:
+			if v == 0 {
+				continue
+			}
+		case *float64:
+			if v == nil {
+				continue
+			}
+			pairs[i+1] = *v
+		case []string:
+			if len(v) == 0 {
+				continue
+			}
+		}
+		fields = append(fields, FieldChange{Field: pairs[i].(string), New: pairs[i+1]})
+	}
+	return fields
+}
+
+// formatFieldValue renders a field value for a plan, quoting strings
+func formatFieldValue(v interface{}) string {
+	switch v := v.(type) {
+	case string, []string:
+		return fmt.Sprintf("%q", v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// equalStrings reports whether two lists hold the same strings in the same order
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
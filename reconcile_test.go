@@ -0,0 +1,294 @@
+package statuspage_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// seededPage holds the IDs of the resources created by seedPage
+type seededPage struct {
+	providerID string
+	components map[string]string
+	templateID string
+}
+
+// seedPage creates the components API, Web and Legacy, the group Backend holding API and Legacy, the
+// incident template Outage and the metric Latency
+func seedPage(t *testing.T, client *statuspage.Client, pageID string) seededPage {
+	t.Helper()
+	ctx := context.Background()
+	seeded := seededPage{components: map[string]string{}}
+
+	names := []string{"API", "Web", "Legacy"}
+	for i, id := range createComponents(t, client, pageID, names...) {
+		seeded.components[names[i]] = id
+	}
+	_, _, err := client.ComponentGroups.Create(ctx, pageID, &statuspage.ComponentGroupInput{
+		Name:       "Backend",
+		Components: []string{seeded.components["API"], seeded.components["Legacy"]},
+	})
+	if err != nil {
+		t.Fatalf("ComponentGroups.Create: %v", err)
+	}
+
+	template, _, err := client.Templates.Create(ctx, pageID, &statuspage.TemplateInput{Name: "Outage", Body: "We are investigating."})
+	if err != nil {
+		t.Fatalf("Templates.Create: %v", err)
+	}
+	seeded.templateID = template.ID
+
+	provider, _, err := client.MetricsProviders.Create(ctx, pageID, &statuspage.MetricsProviderInput{Type: statuspage.MetricsProviderTypeSelf})
+	if err != nil {
+		t.Fatalf("MetricsProviders.Create: %v", err)
+	}
+	seeded.providerID = provider.ID
+	_, _, err = client.MetricsProviders.CreateMetric(ctx, pageID, provider.ID, &statuspage.ProviderMetricInput{
+		MetricInput: statuspage.MetricInput{Name: "Latency"},
+	})
+	if err != nil {
+		t.Fatalf("MetricsProviders.CreateMetric: %v", err)
+	}
+	return seeded
+}
+
+// seededSpec returns a spec that matches the state created by seedPage
+func seededSpec() *statuspage.PageSpec {
+	return &statuspage.PageSpec{
+		Components:      []statuspage.ComponentSpec{{Name: "API"}, {Name: "Web"}, {Name: "Legacy"}},
+		ComponentGroups: []statuspage.ComponentGroupSpec{{Name: "Backend", Components: []string{"API", "Legacy"}}},
+		Templates:       []statuspage.TemplateSpec{{Name: "Outage", Body: "We are investigating."}},
+		Metrics:         []statuspage.MetricSpec{{Name: "Latency"}},
+	}
+}
+
+// planSummary lists the changes of a plan as "<action> <resource> <name>"
+func planSummary(plan *statuspage.Plan) []string {
+	summary := []string{}
+	for _, change := range plan.Changes {
+		summary = append(summary, fmt.Sprintf("%s %s %s", change.Action, change.Resource, change.Name))
+	}
+	return summary
+}
+
+func TestReconcilerPlan(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         func(seeded seededPage) *statuspage.PageSpec
+		want         []string
+		wantWarnings int
+	}{
+		{
+			name: "matching spec",
+			spec: func(seededPage) *statuspage.PageSpec { return seededSpec() },
+			want: []string{},
+		},
+		{
+			name: "nil lists leave resources unmanaged",
+			spec: func(seededPage) *statuspage.PageSpec { return &statuspage.PageSpec{} },
+			want: []string{},
+		},
+		{
+			name: "empty lists delete every resource",
+			spec: func(seededPage) *statuspage.PageSpec {
+				return &statuspage.PageSpec{
+					Components:      []statuspage.ComponentSpec{},
+					ComponentGroups: []statuspage.ComponentGroupSpec{},
+					Templates:       []statuspage.TemplateSpec{},
+					Metrics:         []statuspage.MetricSpec{},
+				}
+			},
+			want: []string{
+				"delete component_group Backend",
+				"delete component API",
+				"delete component Web",
+				"delete component Legacy",
+				"delete metric Latency",
+			},
+			wantWarnings: 1,
+		},
+		{
+			name: "creates, updates and deletes",
+			spec: func(seeded seededPage) *statuspage.PageSpec {
+				spec := seededSpec()
+				spec.Components = []statuspage.ComponentSpec{
+					{Name: "API", Description: "Public REST API"},
+					{Name: "Web"},
+					{Name: "Search"},
+				}
+				spec.ComponentGroups[0].Components = []string{"API", "Search"}
+				spec.Templates = append(spec.Templates, statuspage.TemplateSpec{Name: "Maintenance", Body: "Planned work."})
+				spec.Metrics = []statuspage.MetricSpec{
+					{Name: "Latency", Suffix: "ms"},
+					{Name: "Errors", ProviderID: seeded.providerID},
+				}
+				return spec
+			},
+			want: []string{
+				"update component API",
+				"create component Search",
+				"update component_group Backend",
+				"delete component Legacy",
+				"create template Maintenance",
+				"update metric Latency",
+				"create metric Errors",
+			},
+		},
+		{
+			name: "changed template",
+			spec: func(seededPage) *statuspage.PageSpec {
+				spec := seededSpec()
+				spec.Templates[0].Body = "We are looking into it."
+				return spec
+			},
+			want:         []string{},
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, client, pageID := newFakeClient(t)
+			seeded := seedPage(t, client, pageID)
+
+			plan, err := statuspage.NewReconciler(client, pageID).Plan(context.Background(), tt.spec(seeded))
+			if err != nil {
+				t.Fatalf("Plan: %v", err)
+			}
+			if got := planSummary(plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changes = %q, want %q", got, tt.want)
+			}
+			if plan.Empty() != (len(tt.want) == 0) {
+				t.Errorf("Empty() = %v with %d changes", plan.Empty(), len(plan.Changes))
+			}
+			if len(plan.Warnings) != tt.wantWarnings {
+				t.Errorf("warnings = %q, want %d", plan.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestReconcilerPlanMetricWithoutProvider(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	seedPage(t, client, pageID)
+	spec := seededSpec()
+	spec.Metrics = append(spec.Metrics, statuspage.MetricSpec{Name: "Errors"})
+
+	_, err := statuspage.NewReconciler(client, pageID).Plan(context.Background(), spec)
+	if err == nil || !strings.Contains(err.Error(), `metric "Errors"`) {
+		t.Errorf("Plan error = %v, want an error about the missing metrics provider", err)
+	}
+}
+
+func TestReconcilerReconcile(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	seeded := seedPage(t, client, pageID)
+	ctx := context.Background()
+
+	// Backend and Legacy go away while Frontend is created around the new CDN component
+	spec := seededSpec()
+	spec.Components = []statuspage.ComponentSpec{{Name: "API"}, {Name: "Web"}, {Name: "CDN", Description: "Edge cache"}}
+	spec.ComponentGroups = []statuspage.ComponentGroupSpec{{Name: "Frontend", Components: []string{"Web", "CDN"}}}
+	spec.Metrics = append(spec.Metrics, statuspage.MetricSpec{Name: "Errors", ProviderID: seeded.providerID})
+
+	reconciler := statuspage.NewReconciler(client, pageID)
+	plan, err := reconciler.Reconcile(ctx, spec)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	want := []string{
+		"create component CDN",
+		"create component_group Frontend",
+		"delete component_group Backend",
+		"delete component Legacy",
+		"create metric Errors",
+	}
+	if got := planSummary(plan); !reflect.DeepEqual(got, want) {
+		t.Errorf("changes = %q, want %q", got, want)
+	}
+
+	groups, _, err := client.ComponentGroups.ListAll(ctx, pageID, nil)
+	if err != nil {
+		t.Fatalf("ComponentGroups.ListAll: %v", err)
+	}
+	if len(groups) != 1 || groups[0].Name != "Frontend" {
+		t.Fatalf("groups after Reconcile = %+v, want only Frontend", groups)
+	}
+	components, _, err := client.Components.ListAll(ctx, pageID, nil)
+	if err != nil {
+		t.Fatalf("Components.ListAll: %v", err)
+	}
+	ids := map[string]string{}
+	for _, component := range components {
+		ids[component.Name] = component.ID
+	}
+	if want := []string{ids["Web"], ids["CDN"]}; !reflect.DeepEqual(groups[0].Components, want) {
+		t.Errorf("Frontend components = %v, want %v", groups[0].Components, want)
+	}
+	metrics, _, err := client.MetricsProviders.ListAllMetrics(ctx, pageID, seeded.providerID, nil)
+	if err != nil {
+		t.Fatalf("MetricsProviders.ListAllMetrics: %v", err)
+	}
+	if len(metrics) != 2 {
+		t.Errorf("provider has %d metrics after Reconcile, want 2", len(metrics))
+	}
+
+	again, err := reconciler.Plan(ctx, spec)
+	if err != nil {
+		t.Fatalf("Plan after Reconcile: %v", err)
+	}
+	if !again.Empty() {
+		t.Errorf("Plan after Reconcile has changes:\n%s", again)
+	}
+}
+
+func TestReconcilerDryRun(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	seeded := seedPage(t, client, pageID)
+	ctx := context.Background()
+
+	spec := seededSpec()
+	spec.Components = append(spec.Components, statuspage.ComponentSpec{Name: "CDN", Description: "Edge cache"})
+	spec.Templates = []statuspage.TemplateSpec{}
+
+	var out bytes.Buffer
+	plan, err := statuspage.NewReconciler(client, pageID, statuspage.WithDryRun(&out)).Reconcile(ctx, spec)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	want := fmt.Sprintf(`Plan for page %s: 1 to create, 0 to update, 0 to delete
++ component "CDN"
+    description: "Edge cache"
+! template "Outage" (%s) is not declared, but incident templates cannot be deleted through the API
+`, pageID, seeded.templateID)
+	if out.String() != want {
+		t.Errorf("dry-run output =\n%s\nwant\n%s", out.String(), want)
+	}
+	if plan.String() != want {
+		t.Errorf("plan.String() =\n%s\nwant\n%s", plan.String(), want)
+	}
+
+	components, _, err := client.Components.ListAll(ctx, pageID, nil)
+	if err != nil {
+		t.Fatalf("Components.ListAll: %v", err)
+	}
+	for _, component := range components {
+		if component.Name == "CDN" {
+			t.Error("dry run created component CDN")
+		}
+	}
+
+	out.Reset()
+	if _, err := statuspage.NewReconciler(client, pageID, statuspage.WithDryRun(&out)).Reconcile(ctx, seededSpec()); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if want := fmt.Sprintf("Page %s matches the spec, no changes.\n", pageID); out.String() != want {
+		t.Errorf("dry-run output = %q, want %q", out.String(), want)
+	}
+}
@@ -0,0 +1,88 @@
+package statuspage_test
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MinseokOh/statuspage-sdk-go/statuspagetest"
+)
+
+// onlyReader hides every method but Read, so http.NewRequest cannot set GetBody for it
+type onlyReader struct {
+	io.Reader
+}
+
+func TestRecorderLeavesRequestUntouched(t *testing.T) {
+	fake, _, pageID := newFakeClient(t)
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	const body = `{"component": {"name": "API"}}`
+
+	tests := []struct {
+		name       string
+		newBody    func() io.Reader
+		hasGetBody bool
+	}{
+		{"with GetBody", func() io.Reader { return strings.NewReader(body) }, true},
+		{"without GetBody", func() io.Reader { return onlyReader{strings.NewReader(body)} }, false},
+	}
+
+	roundTrip := func(t *testing.T, recorder *statuspagetest.Recorder, newBody func() io.Reader, hasGetBody bool) *http.Request {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, fake.URL+"/v1/pages/"+pageID+"/components", newBody())
+		if err != nil {
+			t.Fatalf("http.NewRequest: %v", err)
+		}
+		req.Header.Set("Authorization", "OAuth test-key")
+		req.Header.Set("Content-Type", "application/json")
+		if (req.GetBody != nil) != hasGetBody {
+			t.Fatalf("GetBody set = %v, want %v", req.GetBody != nil, hasGetBody)
+		}
+
+		original := req.Body
+		resp, err := recorder.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusCreated)
+		}
+		if req.Body != original {
+			t.Error("RoundTrip replaced req.Body")
+		}
+		return req
+	}
+
+	recorder, err := statuspagetest.NewRecorder(cassette, statuspagetest.ModeRecord)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run("record "+tt.name, func(t *testing.T) {
+			roundTrip(t, recorder, tt.newBody, tt.hasGetBody)
+		})
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	replayer, err := statuspagetest.NewRecorder(cassette, statuspagetest.ModeReplay)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run("replay "+tt.name, func(t *testing.T) {
+			req := roundTrip(t, replayer, tt.newBody, tt.hasGetBody)
+			if !tt.hasGetBody {
+				return
+			}
+			// The body was read through GetBody, so the caller's copy is still unread
+			if got, _ := io.ReadAll(req.Body); string(got) != body {
+				t.Errorf("req.Body after replay = %q, want %q", got, body)
+			}
+		})
+	}
+}
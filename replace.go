@@ -0,0 +1,83 @@
+package statuspage
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// replaceRequest wraps input under key for a PUT request. Unlike the PATCH request types it encodes every
+// persisted, editable field of the input, ignoring omitempty, so attributes the caller left unset are cleared on
+// the server: nil pointers are sent as null, nil slices and maps as empty collections, and everything else as its
+// value. Fields tagged replace:"omitempty", such as required names and enums that cannot be cleared and
+// write-only fields that are not stored, are sent only when set.
+func replaceRequest(key string, input interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(input)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, errors.New("statuspage: replace requires a non-nil input")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, errors.New("statuspage: replace input must be a struct")
+	}
+
+	fields := map[string]interface{}{}
+	collectFields(rv, fields)
+	return map[string]interface{}{key: fields}, nil
+}
+
+// collectFields stores the JSON name and value of every exported field of v, descending into untagged
+// embedded structs the way encoding/json does
+func collectFields(v reflect.Value, fields map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			collectFields(v.Field(i), fields)
+			continue
+		}
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+
+		value := v.Field(i)
+		if field.Tag.Get("replace") == "omitempty" && isEmptyValue(value) {
+			continue
+		}
+		switch {
+		case value.Kind() == reflect.Slice && value.IsNil():
+			fields[name] = reflect.MakeSlice(value.Type(), 0, 0).Interface()
+		case value.Kind() == reflect.Map && value.IsNil():
+			fields[name] = reflect.MakeMap(value.Type()).Interface()
+		default:
+			fields[name] = value.Interface()
+		}
+	}
+}
+
+// isEmptyValue reports whether v is empty in the sense of the omitempty JSON option
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
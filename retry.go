@@ -3,6 +3,8 @@ package statuspage
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/avast/retry-go/v4"
@@ -74,6 +76,9 @@ func WithContext(ctx context.Context) RetryOption {
 	}
 }
 
+// WithOnRetry registers a callback invoked after each failed attempt. When the failed response asked the
+// client to back off via Retry-After or X-RateLimit-Reset, err is an *HTTPError whose RetryAfter field holds
+// the delay that will be waited before the next attempt.
 func WithOnRetry(onRetryFunc func(n uint, err error)) RetryOption {
 	return func(opts []retry.Option) []retry.Option {
 		return append(opts, retry.OnRetry(onRetryFunc))
@@ -96,6 +101,9 @@ func WithRetryIf(retryFunc func(*http.Response, error) bool) RetryOption {
 type HTTPError struct {
 	Response *http.Response
 	Err      error
+
+	// RetryAfter is the delay requested by the server before the next attempt, zero when none was given
+	RetryAfter time.Duration
 }
 
 // Error implements the error interface for HTTPError
@@ -109,6 +117,11 @@ func (e *HTTPError) Error() string {
 	return "unknown HTTP error"
 }
 
+// Unwrap returns the underlying error so errors.Is and errors.As see through retry failures
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
 func DefaultRetryableFunc(resp *http.Response, err error) bool {
 	if err != nil {
 		return true
@@ -148,6 +161,12 @@ func (c *Client) doWithRetry(ctx context.Context, req *http.Request, v interface
 	var lastResp *Response
 	var lastErr error
 
+	timer := &retryAfterTimer{}
+	opts := make([]retry.Option, 0, len(retryOptions)+2)
+	opts = append(opts, retry.Context(ctx))
+	opts = append(opts, retryOptions...)
+	opts = append(opts, retry.WithTimer(timer))
+
 	err := retry.Do(func() error {
 		reqCopy := c.cloneRequest(ctx, req)
 		resp, err := c.doRequest(ctx, reqCopy, v)
@@ -155,11 +174,17 @@ func (c *Client) doWithRetry(ctx context.Context, req *http.Request, v interface
 		lastErr = err
 
 		if err != nil {
-			return &HTTPError{Response: resp.Response, Err: err}
+			httpErr := &HTTPError{Err: err}
+			if resp != nil {
+				httpErr.Response = resp.Response
+				httpErr.RetryAfter = c.retryAfterDelay(resp, time.Now())
+			}
+			timer.delay = httpErr.RetryAfter
+			return httpErr
 		}
 
 		return nil
-	}, retryOptions...)
+	}, opts...)
 
 	if err != nil {
 		return lastResp, lastErr
@@ -168,6 +193,61 @@ func (c *Client) doWithRetry(ctx context.Context, req *http.Request, v interface
 	return lastResp, nil
 }
 
+// retryAfterTimer waits for the delay requested by the last failed response instead of the computed backoff
+type retryAfterTimer struct {
+	delay time.Duration
+}
+
+// After implements retry.Timer
+func (t *retryAfterTimer) After(d time.Duration) <-chan time.Time {
+	if t.delay > 0 {
+		d = t.delay
+	}
+	return time.After(d)
+}
+
+// retryAfterDelay returns how long the server asked the client to wait before retrying, capped by the
+// client's maximum. Retry-After is honored on 420, 429 and 503 responses, and rate limited responses
+// without it fall back to the X-RateLimit-Reset header.
+func (c *Client) retryAfterDelay(resp *Response, now time.Time) time.Duration {
+	var delay time.Duration
+	switch resp.StatusCode {
+	case StatusTooManyRequestsEnhanceYourCalm, http.StatusTooManyRequests:
+		delay = parseRetryAfter(resp.Header.Get("Retry-After"), now)
+		if delay == 0 && !resp.Rate.Reset.IsZero() {
+			delay = resp.Rate.Reset.Sub(now)
+		}
+	case http.StatusServiceUnavailable:
+		delay = parseRetryAfter(resp.Header.Get("Retry-After"), now)
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+	if c.maxRetryAfter > 0 && delay > c.maxRetryAfter {
+		delay = c.maxRetryAfter
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header given either as delay seconds or as an HTTP-date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return at.Sub(now)
+	}
+
+	return 0
+}
+
 func (c *Client) cloneRequest(ctx context.Context, req *http.Request) *http.Request {
 	reqCopy := req.Clone(ctx)
 
@@ -0,0 +1,144 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{"missing", "", 0},
+		{"seconds", "120", 2 * time.Minute},
+		{"seconds with spaces", " 5 ", 5 * time.Second},
+		{"HTTP-date", now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second},
+		{"HTTP-date in the past", now.Add(-time.Minute).Format(http.TimeFormat), -time.Minute},
+		{"malformed", "soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	reset := func(d time.Duration) string {
+		return strconv.FormatInt(now.Add(d).Unix(), 10)
+	}
+	tests := []struct {
+		name    string
+		status  int
+		headers map[string]string
+		opts    []ClientOption
+		want    time.Duration
+	}{
+		{"429 seconds", http.StatusTooManyRequests, map[string]string{"Retry-After": "30"}, nil, 30 * time.Second},
+		{"429 HTTP-date", http.StatusTooManyRequests, map[string]string{"Retry-After": now.Add(45 * time.Second).Format(http.TimeFormat)}, nil, 45 * time.Second},
+		{"420 seconds", StatusTooManyRequestsEnhanceYourCalm, map[string]string{"Retry-After": "10"}, nil, 10 * time.Second},
+		{"503 seconds", http.StatusServiceUnavailable, map[string]string{"Retry-After": "20"}, nil, 20 * time.Second},
+		{"500 ignores Retry-After", http.StatusInternalServerError, map[string]string{"Retry-After": "30"}, nil, 0},
+		{"429 falls back to rate limit reset", http.StatusTooManyRequests, map[string]string{"X-RateLimit-Reset": reset(45 * time.Second)}, nil, 45 * time.Second},
+		{"Retry-After wins over rate limit reset", http.StatusTooManyRequests, map[string]string{"Retry-After": "5", "X-RateLimit-Reset": reset(45 * time.Second)}, nil, 5 * time.Second},
+		{"rate limit reset in the past", http.StatusTooManyRequests, map[string]string{"X-RateLimit-Reset": reset(-time.Minute)}, nil, 0},
+		{"503 has no rate limit reset fallback", http.StatusServiceUnavailable, map[string]string{"X-RateLimit-Reset": reset(45 * time.Second)}, nil, 0},
+		{"HTTP-date in the past", http.StatusTooManyRequests, map[string]string{"Retry-After": now.Add(-time.Minute).Format(http.TimeFormat)}, nil, 0},
+		{"default cap", http.StatusTooManyRequests, map[string]string{"Retry-After": "600"}, nil, DefaultMaxRetryAfter},
+		{"custom cap", http.StatusTooManyRequests, map[string]string{"Retry-After": "600"}, []ClientOption{WithMaxRetryAfter(5 * time.Second)}, 5 * time.Second},
+		{"cap applies to rate limit reset", http.StatusTooManyRequests, map[string]string{"X-RateLimit-Reset": reset(time.Hour)}, []ClientOption{WithMaxRetryAfter(5 * time.Second)}, 5 * time.Second},
+		{"no cap", http.StatusTooManyRequests, map[string]string{"Retry-After": "600"}, []ClientOption{WithMaxRetryAfter(0)}, 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for key, value := range tt.headers {
+				header.Set(key, value)
+			}
+			resp := newResponse(&http.Response{StatusCode: tt.status, Header: header})
+
+			client := NewClient("test-key", tt.opts...)
+			if got := client.retryAfterDelay(resp, now); got != tt.want {
+				t.Errorf("retryAfterDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfterTimer(t *testing.T) {
+	timer := &retryAfterTimer{}
+	select {
+	case <-timer.After(time.Millisecond):
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer without a requested delay did not use the computed delay")
+	}
+
+	timer.delay = time.Millisecond
+	select {
+	case <-timer.After(time.Hour):
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not use the requested delay instead of the computed one")
+	}
+}
+
+func TestDoWithRetryHonorsRetryAfter(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id": "p1"}`))
+	}))
+	defer server.Close()
+
+	var retryAfter time.Duration
+	// The computed backoff is an hour, so the test only finishes if the capped Retry-After is used instead
+	client := NewClient("test-key",
+		WithBaseURL(server.URL+"/v1/"),
+		WithMaxRetryAfter(10*time.Millisecond),
+		WithRetryOptions(
+			WithAttempts(2),
+			WithDelayType(func(n uint, err error, config *retry.Config) time.Duration { return time.Hour }),
+			WithOnRetry(func(n uint, err error) {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					retryAfter = httpErr.RetryAfter
+				}
+			}),
+		),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	page, _, err := client.Pages.Get(ctx, "p1")
+	if err != nil {
+		t.Fatalf("Pages.Get: %v", err)
+	}
+	if page.ID != "p1" {
+		t.Errorf("page.ID = %q, want %q", page.ID, "p1")
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+	if retryAfter != 10*time.Millisecond {
+		t.Errorf("HTTPError.RetryAfter = %v, want the capped 10ms", retryAfter)
+	}
+}
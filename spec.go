@@ -0,0 +1,167 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PageSpec declares the desired configuration of a status page for a Reconciler. Resources are matched to
+// live ones by name. A nil list leaves that kind of resource unmanaged, while an empty list means the page
+// should have none, so every live resource of that kind is deleted.
+//
+// Specs are usually kept in files and read with LoadPageSpec or LoadPageSpecYAML.
+type PageSpec struct {
+	Components        []ComponentSpec        `json:"components,omitempty" yaml:"components,omitempty"`
+	ComponentGroups   []ComponentGroupSpec   `json:"component_groups,omitempty" yaml:"component_groups,omitempty"`
+	Templates         []TemplateSpec         `json:"templates,omitempty" yaml:"templates,omitempty"`
+	Metrics           []MetricSpec           `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+	StatusEmbedConfig *StatusEmbedConfigSpec `json:"status_embed_config,omitempty" yaml:"status_embed_config,omitempty"`
+}
+
+// ComponentSpec declares a component. Its group membership is declared on the ComponentGroupSpec.
+type ComponentSpec struct {
+	Name               string `json:"name" yaml:"name"`
+	Description        string `json:"description,omitempty" yaml:"description,omitempty"`
+	OnlyShowIfDegraded bool   `json:"only_show_if_degraded,omitempty" yaml:"only_show_if_degraded,omitempty"`
+	Showcase           bool   `json:"showcase,omitempty" yaml:"showcase,omitempty"`
+}
+
+// ComponentGroupSpec declares a component group and the names of its member components
+type ComponentGroupSpec struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Components  []string `json:"components" yaml:"components"`
+}
+
+// TemplateSpec declares an incident template. The API can only create incident templates, so a Reconciler
+// creates missing ones and reports differences in existing ones as plan warnings.
+type TemplateSpec struct {
+	Name                    string `json:"name" yaml:"name"`
+	Body                    string `json:"body" yaml:"body"`
+	UpdateStatus            string `json:"update_status,omitempty" yaml:"update_status,omitempty"`
+	ShouldTweet             bool   `json:"should_tweet,omitempty" yaml:"should_tweet,omitempty"`
+	ShouldSendNotifications bool   `json:"should_send_notifications,omitempty" yaml:"should_send_notifications,omitempty"`
+}
+
+// MetricSpec declares a system metric. YAxisMin and YAxisMax are left as they are when nil.
+//
+// Metrics are created through a metrics provider, so ProviderID is required for a metric that does not exist
+// yet. It is ignored for existing metrics.
+type MetricSpec struct {
+	Name          string   `json:"name" yaml:"name"`
+	ProviderID    string   `json:"metrics_provider_id,omitempty" yaml:"metrics_provider_id,omitempty"`
+	DisplayName   string   `json:"display_name,omitempty" yaml:"display_name,omitempty"`
+	Suffix        string   `json:"suffix,omitempty" yaml:"suffix,omitempty"`
+	Tooltip       string   `json:"tooltip,omitempty" yaml:"tooltip,omitempty"`
+	DecimalPlaces int      `json:"decimal_places,omitempty" yaml:"decimal_places,omitempty"`
+	YAxisMin      *float64 `json:"y_axis_min,omitempty" yaml:"y_axis_min,omitempty"`
+	YAxisMax      *float64 `json:"y_axis_max,omitempty" yaml:"y_axis_max,omitempty"`
+	YAxisHidden   bool     `json:"y_axis_hidden,omitempty" yaml:"y_axis_hidden,omitempty"`
+}
+
+// StatusEmbedConfigSpec declares the status embed widget settings. Empty fields are left as they are.
+type StatusEmbedConfigSpec struct {
+	Position                   string `json:"position,omitempty" yaml:"position,omitempty"`
+	IncidentBackgroundColor    string `json:"incident_background_color,omitempty" yaml:"incident_background_color,omitempty"`
+	IncidentTextColor          string `json:"incident_text_color,omitempty" yaml:"incident_text_color,omitempty"`
+	MaintenanceBackgroundColor string `json:"maintenance_background_color,omitempty" yaml:"maintenance_background_color,omitempty"`
+	MaintenanceTextColor       string `json:"maintenance_text_color,omitempty" yaml:"maintenance_text_color,omitempty"`
+}
+
+// LoadPageSpec decodes a JSON page spec and validates it. Unknown fields are rejected so that typos do not
+// silently leave settings unmanaged.
+func LoadPageSpec(r io.Reader) (*PageSpec, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	spec := new(PageSpec)
+	if err := dec.Decode(spec); err != nil {
+		return nil, fmt.Errorf("statuspage: decoding page spec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// LoadPageSpecYAML decodes a YAML page spec and validates it. Like LoadPageSpec it rejects unknown fields.
+func LoadPageSpecYAML(r io.Reader) (*PageSpec, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	spec := new(PageSpec)
+	if err := dec.Decode(spec); err != nil {
+		return nil, fmt.Errorf("statuspage: decoding page spec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// Validate checks that every resource is named, that names are unique within each kind and that groups only
+// list declared components, each at most once
+func (s *PageSpec) Validate() error {
+	var problems []string
+	check := func(kind string, names []string) map[string]bool {
+		seen := map[string]bool{}
+		for _, name := range names {
+			switch {
+			case name == "":
+				problems = append(problems, kind+" without a name")
+			case seen[name]:
+				problems = append(problems, fmt.Sprintf("duplicate %s %q", kind, name))
+			}
+			seen[name] = true
+		}
+		return seen
+	}
+
+	var names []string
+	for _, component := range s.Components {
+		names = append(names, component.Name)
+	}
+	components := check("component", names)
+
+	names = nil
+	grouped := map[string]string{}
+	for _, group := range s.ComponentGroups {
+		names = append(names, group.Name)
+		if len(group.Components) == 0 {
+			problems = append(problems, fmt.Sprintf("component group %q has no components", group.Name))
+		}
+		for _, member := range group.Components {
+			switch {
+			case s.Components != nil && !components[member]:
+				problems = append(problems, fmt.Sprintf("component group %q lists undeclared component %q", group.Name, member))
+			case grouped[member] != "":
+				problems = append(problems, fmt.Sprintf("component %q is in both %q and %q", member, grouped[member], group.Name))
+			}
+			grouped[member] = group.Name
+		}
+	}
+	check("component group", names)
+
+	names = nil
+	for _, template := range s.Templates {
+		names = append(names, template.Name)
+	}
+	check("template", names)
+
+	names = nil
+	for _, metric := range s.Metrics {
+		names = append(names, metric.Name)
+	}
+	check("metric", names)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("statuspage: invalid page spec: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package statuspage_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+func TestPageSpecValidate(t *testing.T) {
+	components := []statuspage.ComponentSpec{{Name: "API"}, {Name: "Web"}}
+	tests := []struct {
+		name    string
+		spec    statuspage.PageSpec
+		wantErr string
+	}{
+		{
+			name: "valid",
+			spec: statuspage.PageSpec{
+				Components:      components,
+				ComponentGroups: []statuspage.ComponentGroupSpec{{Name: "Backend", Components: []string{"API"}}},
+				Templates:       []statuspage.TemplateSpec{{Name: "Outage", Body: "Down"}},
+				Metrics:         []statuspage.MetricSpec{{Name: "Latency"}},
+			},
+		},
+		{
+			name: "groups may list unmanaged components",
+			spec: statuspage.PageSpec{
+				ComponentGroups: []statuspage.ComponentGroupSpec{{Name: "Backend", Components: []string{"API"}}},
+			},
+		},
+		{
+			name:    "unnamed component",
+			spec:    statuspage.PageSpec{Components: []statuspage.ComponentSpec{{Description: "API"}}},
+			wantErr: "component without a name",
+		},
+		{
+			name:    "duplicate component",
+			spec:    statuspage.PageSpec{Components: []statuspage.ComponentSpec{{Name: "API"}, {Name: "API"}}},
+			wantErr: `duplicate component "API"`,
+		},
+		{
+			name: "empty group",
+			spec: statuspage.PageSpec{
+				Components:      components,
+				ComponentGroups: []statuspage.ComponentGroupSpec{{Name: "Backend"}},
+			},
+			wantErr: `component group "Backend" has no components`,
+		},
+		{
+			name: "undeclared member",
+			spec: statuspage.PageSpec{
+				Components:      components,
+				ComponentGroups: []statuspage.ComponentGroupSpec{{Name: "Backend", Components: []string{"DB"}}},
+			},
+			wantErr: `component group "Backend" lists undeclared component "DB"`,
+		},
+		{
+			name: "component in two groups",
+			spec: statuspage.PageSpec{
+				Components: components,
+				ComponentGroups: []statuspage.ComponentGroupSpec{
+					{Name: "Backend", Components: []string{"API"}},
+					{Name: "Public", Components: []string{"API", "Web"}},
+				},
+			},
+			wantErr: `component "API" is in both "Backend" and "Public"`,
+		},
+		{
+			name:    "duplicate template",
+			spec:    statuspage.PageSpec{Templates: []statuspage.TemplateSpec{{Name: "Outage"}, {Name: "Outage"}}},
+			wantErr: `duplicate template "Outage"`,
+		},
+		{
+			name:    "duplicate metric",
+			spec:    statuspage.PageSpec{Metrics: []statuspage.MetricSpec{{Name: "Latency"}, {Name: "Latency"}}},
+			wantErr: `duplicate metric "Latency"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadPageSpec(t *testing.T) {
+	loaders := []struct {
+		name string
+		load func(r io.Reader) (*statuspage.PageSpec, error)
+	}{
+		{"JSON", statuspage.LoadPageSpec},
+		{"YAML", statuspage.LoadPageSpecYAML},
+	}
+	// JSON is valid YAML, so every document is fed to both loaders
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr string
+		check   func(t *testing.T, spec *statuspage.PageSpec)
+	}{
+		{
+			name: "valid",
+			doc:  `{"components": [{"name": "API", "showcase": true}], "metrics": [{"name": "Latency", "metrics_provider_id": "p1"}]}`,
+			check: func(t *testing.T, spec *statuspage.PageSpec) {
+				if len(spec.Components) != 1 || spec.Components[0].Name != "API" || !spec.Components[0].Showcase {
+					t.Errorf("Components = %+v", spec.Components)
+				}
+				if len(spec.Metrics) != 1 || spec.Metrics[0].ProviderID != "p1" {
+					t.Errorf("Metrics = %+v", spec.Metrics)
+				}
+			},
+		},
+		{
+			name: "empty and missing lists",
+			doc:  `{"components": [], "templates": []}`,
+			check: func(t *testing.T, spec *statuspage.PageSpec) {
+				if spec.Components == nil || len(spec.Components) != 0 {
+					t.Errorf("Components = %#v, want an empty list", spec.Components)
+				}
+				if spec.Templates == nil || len(spec.Templates) != 0 {
+					t.Errorf("Templates = %#v, want an empty list", spec.Templates)
+				}
+				if spec.ComponentGroups != nil || spec.Metrics != nil {
+					t.Errorf("missing lists = %#v, %#v, want nil", spec.ComponentGroups, spec.Metrics)
+				}
+			},
+		},
+		{
+			name:    "unknown top-level field",
+			doc:     `{"componets": [{"name": "API"}]}`,
+			wantErr: "componets",
+		},
+		{
+			name:    "unknown nested field",
+			doc:     `{"components": [{"name": "API", "descripton": "REST"}]}`,
+			wantErr: "descripton",
+		},
+		{
+			name:    "invalid spec",
+			doc:     `{"components": [{"name": "API"}, {"name": "API"}]}`,
+			wantErr: `duplicate component "API"`,
+		},
+	}
+
+	for _, loader := range loaders {
+		for _, tt := range tests {
+			t.Run(loader.name+"/"+tt.name, func(t *testing.T) {
+				spec, err := loader.load(strings.NewReader(tt.doc))
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Errorf("error = %v, want an error containing %q", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				tt.check(t, spec)
+			})
+		}
+	}
+}
+
+func TestLoadPageSpecYAML(t *testing.T) {
+	spec, err := statuspage.LoadPageSpecYAML(strings.NewReader(`
+components:
+  - name: API
+    description: Public REST API
+  - name: Web
+component_groups:
+  - name: Backend
+    components: [API]
+status_embed_config:
+  position: bottom-left
+`))
+	if err != nil {
+		t.Fatalf("LoadPageSpecYAML: %v", err)
+	}
+	if len(spec.Components) != 2 || spec.Components[0].Description != "Public REST API" {
+		t.Errorf("Components = %+v", spec.Components)
+	}
+	if len(spec.ComponentGroups) != 1 || spec.ComponentGroups[0].Components[0] != "API" {
+		t.Errorf("ComponentGroups = %+v", spec.ComponentGroups)
+	}
+	if spec.StatusEmbedConfig == nil || spec.StatusEmbedConfig.Position != "bottom-left" {
+		t.Errorf("StatusEmbedConfig = %+v", spec.StatusEmbedConfig)
+	}
+}
@@ -0,0 +1,144 @@
+package statuspagetest
+
+import (
+	"net/http"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handleComponentGroups serves /pages/{page_id}/component-groups and its sub-resources
+func (s *Server) handleComponentGroups(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, ps.componentGroups.list()))
+		case http.MethodPost:
+			s.createComponentGroup(w, r, ps)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	group, ok := ps.componentGroups.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Component group not found")
+		return
+	}
+	if len(rest) > 1 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodPatch, http.MethodPut:
+		s.updateComponentGroup(w, r, ps, group)
+	case http.MethodDelete:
+		s.setGroupMembers(ps, group, nil)
+		ps.componentGroups.remove(group.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createComponentGroup(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	input, err := readInput(r, "component_group")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	group := &statuspage.ComponentGroup{}
+	if err := applyInput(group, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if msgs := validateComponentGroup(ps, group); len(msgs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, msgs...)
+		return
+	}
+
+	now := s.now()
+	group.ID = s.newID()
+	group.PageID = ps.page.ID
+	group.CreatedAt = statuspage.Time{Time: now}
+	group.UpdatedAt = statuspage.Time{Time: now}
+	if group.Position == 0 {
+		group.Position = len(ps.componentGroups.order) + 1
+	}
+	members := group.Components
+	group.Components = nil
+	ps.componentGroups.add(group.ID, group)
+	s.setGroupMembers(ps, group, members)
+
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (s *Server) updateComponentGroup(w http.ResponseWriter, r *http.Request, ps *pageState, group *statuspage.ComponentGroup) {
+	input, err := readInput(r, "component_group")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated := *group
+	if err := applyInput(&updated, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if msgs := validateComponentGroup(ps, &updated); len(msgs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, msgs...)
+		return
+	}
+
+	members := updated.Components
+	updated.Components = group.Components
+	updated.ID = group.ID
+	updated.PageID = group.PageID
+	updated.CreatedAt = group.CreatedAt
+	updated.UpdatedAt = statuspage.Time{Time: s.now()}
+	*group = updated
+	s.setGroupMembers(ps, group, members)
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+// validateComponentGroup returns the validation messages for a component group, if any
+func validateComponentGroup(ps *pageState, group *statuspage.ComponentGroup) []string {
+	var msgs []string
+	if group.Name == "" {
+		msgs = append(msgs, "Name can't be blank")
+	}
+	if len(group.Components) == 0 {
+		msgs = append(msgs, "Components can't be blank")
+	}
+	for _, id := range group.Components {
+		if _, ok := ps.components.get(id); !ok {
+			msgs = append(msgs, "Component "+id+" could not be found")
+		}
+	}
+	return msgs
+}
+
+// setGroupMembers moves the given components into group and releases components no longer listed
+func (s *Server) setGroupMembers(ps *pageState, group *statuspage.ComponentGroup, members []string) {
+	for _, id := range group.Components {
+		if component, ok := ps.components.get(id); ok && component.GroupID == group.ID {
+			component.GroupID = ""
+		}
+	}
+	group.Components = nil
+
+	for _, id := range members {
+		component, ok := ps.components.get(id)
+		if !ok {
+			continue
+		}
+		s.detachComponent(ps, component)
+		component.GroupID = group.ID
+		group.Components = append(group.Components, id)
+	}
+}
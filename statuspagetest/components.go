@@ -0,0 +1,162 @@
+package statuspagetest
+
+import (
+	"net/http"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// validComponentStatuses lists the statuses a component may be set to
+var validComponentStatuses = map[string]bool{
+	statuspage.ComponentStatusOperational:         true,
+	statuspage.ComponentStatusDegradedPerformance: true,
+	statuspage.ComponentStatusPartialOutage:       true,
+	statuspage.ComponentStatusMajorOutage:         true,
+	statuspage.ComponentStatusUnderMaintenance:    true,
+}
+
+// handleComponents serves /pages/{page_id}/components and its sub-resources
+func (s *Server) handleComponents(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, ps.components.list()))
+		case http.MethodPost:
+			s.createComponent(w, r, ps)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	component, ok := ps.components.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Component not found")
+		return
+	}
+	if len(rest) > 1 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, component)
+	case http.MethodPatch, http.MethodPut:
+		s.updateComponent(w, r, ps, component)
+	case http.MethodDelete:
+		ps.components.remove(component.ID)
+		s.detachComponent(ps, component)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createComponent(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	input, err := readInput(r, "component")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	component := &statuspage.Component{Status: statuspage.ComponentStatusOperational}
+	if err := applyInput(component, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if msgs := validateComponent(ps, component); len(msgs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, msgs...)
+		return
+	}
+
+	now := s.now()
+	component.ID = s.newID()
+	component.PageID = ps.page.ID
+	component.CreatedAt = statuspage.Time{Time: now}
+	component.UpdatedAt = statuspage.Time{Time: now}
+	component.Position = len(ps.components.order) + 1
+	ps.components.add(component.ID, component)
+	s.attachComponent(ps, component)
+
+	writeJSON(w, http.StatusCreated, component)
+}
+
+func (s *Server) updateComponent(w http.ResponseWriter, r *http.Request, ps *pageState, component *statuspage.Component) {
+	input, err := readInput(r, "component")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated := *component
+	if err := applyInput(&updated, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if msgs := validateComponent(ps, &updated); len(msgs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, msgs...)
+		return
+	}
+
+	s.detachComponent(ps, component)
+	updated.ID = component.ID
+	updated.PageID = component.PageID
+	updated.CreatedAt = component.CreatedAt
+	updated.UpdatedAt = statuspage.Time{Time: s.now()}
+	*component = updated
+	s.attachComponent(ps, component)
+
+	writeJSON(w, http.StatusOK, component)
+}
+
+// validateComponent returns the validation messages for a component, if any
+func validateComponent(ps *pageState, component *statuspage.Component) []string {
+	var msgs []string
+	if component.Name == "" {
+		msgs = append(msgs, "Name can't be blank")
+	}
+	if !validComponentStatuses[component.Status] {
+		msgs = append(msgs, "Status is not included in the list")
+	}
+	if component.GroupID != "" {
+		if _, ok := ps.componentGroups.get(component.GroupID); !ok {
+			msgs = append(msgs, "Group could not be found")
+		}
+	}
+	return msgs
+}
+
+// attachComponent adds a component to the member list of its group
+func (s *Server) attachComponent(ps *pageState, component *statuspage.Component) {
+	group, ok := ps.componentGroups.get(component.GroupID)
+	if !ok {
+		return
+	}
+	for _, id := range group.Components {
+		if id == component.ID {
+			return
+		}
+	}
+	group.Components = append(group.Components, component.ID)
+}
+
+// detachComponent removes a component from the member list of its group
+func (s *Server) detachComponent(ps *pageState, component *statuspage.Component) {
+	group, ok := ps.componentGroups.get(component.GroupID)
+	if !ok {
+		return
+	}
+	group.Components = removeString(group.Components, component.ID)
+}
+
+// removeString returns list without any occurrence of value
+func removeString(list []string, value string) []string {
+	out := list[:0]
+	for _, v := range list {
+		if v != value {
+			out = append(out, v)
+		}
+	}
+	return out
+}
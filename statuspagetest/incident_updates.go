@@ -0,0 +1,88 @@
+package statuspagetest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handleIncidentUpdates serves /pages/{page_id}/incidents/{incident_id}/incident_updates and its sub-resources
+func (s *Server) handleIncidentUpdates(w http.ResponseWriter, r *http.Request, ps *pageState, incident *statuspage.Incident, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, incident.IncidentUpdates)
+		case http.MethodPost:
+			s.createIncidentUpdate(w, r, ps, incident)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	var update *statuspage.IncidentUpdate
+	for i := range incident.IncidentUpdates {
+		if incident.IncidentUpdates[i].ID == rest[0] {
+			update = &incident.IncidentUpdates[i]
+		}
+	}
+	if update == nil || len(rest) > 1 {
+		writeError(w, http.StatusNotFound, "Incident update not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, update)
+	case http.MethodPatch, http.MethodPut:
+		input, err := readInput(r, "incident_update")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		updated := *update
+		err = applyInput(&updated, without(input, "id", "incident_id", "created_at", "updated_at", "components", "affected_components"))
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		updated.ID = update.ID
+		updated.IncidentID = update.IncidentID
+		updated.CreatedAt = update.CreatedAt
+		updated.UpdatedAt = s.now()
+		*update = updated
+
+		writeJSON(w, http.StatusOK, update)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createIncidentUpdate(w http.ResponseWriter, r *http.Request, ps *pageState, incident *statuspage.Incident) {
+	input, err := readInput(r, "incident_update")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if stringAttr(input, "body") == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Body can't be blank")
+		return
+	}
+
+	if status := stringAttr(input, "status"); status != "" {
+		incident.Status = status
+	}
+
+	var affected []string
+	json.Unmarshal(input["affected_components"], &affected)
+	for _, id := range affected {
+		if !containsString(incident.ComponentIDs, id) {
+			incident.ComponentIDs = append(incident.ComponentIDs, id)
+		}
+	}
+
+	s.recordIncidentChange(ps, incident, input, s.now())
+	writeJSON(w, http.StatusCreated, incident.IncidentUpdates[0])
+}
@@ -0,0 +1,349 @@
+package statuspagetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// incidentInputOnly lists IncidentInput attributes that are not part of the Incident model
+var incidentInputOnly = []string{
+	"id", "page_id", "created_at", "updated_at", "components", "body", "deliver_notifications",
+	"incident_updates", "resolved_at", "monitoring_at", "impact", "shortlink",
+}
+
+// handleIncidents serves /pages/{page_id}/incidents and its sub-resources
+func (s *Server) handleIncidents(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, filterIncidents(r, ps)))
+		case http.MethodPost:
+			s.createIncident(w, r, ps)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if len(rest) == 1 && r.Method == http.MethodGet {
+		switch rest[0] {
+		case "unresolved":
+			writeJSON(w, http.StatusOK, paginate(r, selectIncidents(ps, isUnresolved)))
+			return
+		case "scheduled":
+			writeJSON(w, http.StatusOK, paginate(r, selectIncidents(ps, isScheduled)))
+			return
+		case "upcoming":
+			now := s.now()
+			writeJSON(w, http.StatusOK, paginate(r, selectIncidents(ps, func(incident *statuspage.Incident) bool {
+				return isScheduled(incident) && (incident.ScheduledFor == nil || incident.ScheduledFor.After(now))
+			})))
+			return
+		case "active_maintenance":
+			writeJSON(w, http.StatusOK, paginate(r, selectIncidents(ps, isActiveMaintenance)))
+			return
+		}
+	}
+
+	incident, ok := ps.incidents.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Incident not found")
+		return
+	}
+
+	if len(rest) > 1 {
+		switch rest[1] {
+		case "incident_updates":
+			s.handleIncidentUpdates(w, r, ps, incident, rest[2:])
+			return
+		case "postmortem":
+			s.handlePostmortem(w, r, ps, incident, rest[2:])
+			return
+		case "subscribers":
+			s.handleIncidentSubscribers(w, r, ps, incident, rest[2:])
+			return
+		}
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, incident)
+	case http.MethodPatch, http.MethodPut:
+		s.updateIncident(w, r, ps, incident)
+	case http.MethodDelete:
+		ps.incidents.remove(incident.ID)
+		delete(ps.postmortems, incident.ID)
+		delete(ps.incidentSubscribers, incident.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createIncident(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	input, err := readInput(r, "incident")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	incident := &statuspage.Incident{}
+	if err := applyInput(incident, without(input, incidentInputOnly...)); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if incident.Status == "" {
+		incident.Status = statuspage.IncidentStatusInvestigating
+		if incident.ScheduledFor != nil {
+			incident.Status = statuspage.IncidentStatusScheduled
+		}
+	}
+	if msgs := validateIncident(ps, incident); len(msgs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, msgs...)
+		return
+	}
+
+	now := s.now()
+	incident.ID = s.newID()
+	incident.PageID = ps.page.ID
+	incident.CreatedAt = statuspage.Time{Time: now}
+	incident.Shortlink = "https://stspg.io/" + incident.ID
+	ps.incidents.add(incident.ID, incident)
+
+	s.recordIncidentChange(ps, incident, input, now)
+	writeJSON(w, http.StatusCreated, incident)
+}
+
+func (s *Server) updateIncident(w http.ResponseWriter, r *http.Request, ps *pageState, incident *statuspage.Incident) {
+	input, err := readInput(r, "incident")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated := *incident
+	if err := applyInput(&updated, without(input, incidentInputOnly...)); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if msgs := validateIncident(ps, &updated); len(msgs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, msgs...)
+		return
+	}
+
+	updated.ID = incident.ID
+	updated.PageID = incident.PageID
+	updated.CreatedAt = incident.CreatedAt
+	updated.Shortlink = incident.Shortlink
+	updated.IncidentUpdates = incident.IncidentUpdates
+	statusChanged := updated.Status != incident.Status
+	*incident = updated
+
+	_, hasBody := input["body"]
+	_, hasComponents := input["components"]
+	if statusChanged || hasBody || hasComponents {
+		s.recordIncidentChange(ps, incident, input, s.now())
+	} else {
+		s.refreshIncident(ps, incident, nil, s.now())
+	}
+	writeJSON(w, http.StatusOK, incident)
+}
+
+// recordIncidentChange applies component status changes from input and prepends a new incident update
+func (s *Server) recordIncidentChange(ps *pageState, incident *statuspage.Incident, input map[string]json.RawMessage, now time.Time) {
+	var statuses map[string]string
+	json.Unmarshal(input["components"], &statuses)
+	affected := s.applyComponentStatuses(ps, statuses)
+
+	var deliver bool
+	json.Unmarshal(input["deliver_notifications"], &deliver)
+
+	update := statuspage.IncidentUpdate{
+		ID:                   s.newID(),
+		IncidentID:           incident.ID,
+		AffectedComponents:   affected,
+		Body:                 stringAttr(input, "body"),
+		CreatedAt:            now,
+		DeliverNotifications: deliver,
+		DisplayAt:            now,
+		Status:               incident.Status,
+		UpdatedAt:            now,
+	}
+	incident.IncidentUpdates = append([]statuspage.IncidentUpdate{update}, incident.IncidentUpdates...)
+
+	s.refreshIncident(ps, incident, statuses, now)
+}
+
+// refreshIncident recomputes the derived incident attributes after a change
+func (s *Server) refreshIncident(ps *pageState, incident *statuspage.Incident, statuses map[string]string, now time.Time) {
+	ids := append([]string{}, incident.ComponentIDs...)
+	for id := range statuses {
+		if !containsString(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	incident.ComponentIDs = ids
+
+	incident.Components = nil
+	worst := statuspage.ComponentStatusOperational
+	for _, id := range ids {
+		if component, ok := ps.components.get(id); ok {
+			incident.Components = append(incident.Components, *component)
+			if componentSeverity(component.Status) > componentSeverity(worst) {
+				worst = component.Status
+			}
+		}
+	}
+
+	switch {
+	case incident.ImpactOverride != "":
+		incident.Impact = incident.ImpactOverride
+	case incident.ScheduledFor != nil:
+		incident.Impact = statuspage.IncidentImpactMaintenance
+	default:
+		incident.Impact = impactForStatus(worst)
+	}
+
+	if incident.Status == statuspage.IncidentStatusMonitoring && incident.MonitoringAt == nil {
+		incident.MonitoringAt = &statuspage.Time{Time: now}
+	}
+	if isClosed(incident) {
+		if incident.ResolvedAt == nil {
+			incident.ResolvedAt = &statuspage.Time{Time: now}
+		}
+	} else {
+		incident.ResolvedAt = nil
+	}
+	incident.UpdatedAt = statuspage.Time{Time: now}
+}
+
+// applyComponentStatuses updates component statuses and returns the affected component records
+func (s *Server) applyComponentStatuses(ps *pageState, statuses map[string]string) []statuspage.AffectedComponent {
+	var affected []statuspage.AffectedComponent
+	for _, component := range ps.components.list() {
+		status, ok := statuses[component.ID]
+		if !ok {
+			continue
+		}
+		affected = append(affected, statuspage.AffectedComponent{
+			Code:      component.ID,
+			Name:      component.Name,
+			OldStatus: component.Status,
+			NewStatus: status,
+		})
+		component.Status = status
+		component.UpdatedAt = statuspage.Time{Time: s.now()}
+	}
+	return affected
+}
+
+// validateIncident returns the validation messages for an incident, if any
+func validateIncident(ps *pageState, incident *statuspage.Incident) []string {
+	var msgs []string
+	if incident.Name == "" {
+		msgs = append(msgs, "Name can't be blank")
+	}
+	for _, id := range incident.ComponentIDs {
+		if _, ok := ps.components.get(id); !ok {
+			msgs = append(msgs, "Component "+id+" could not be found")
+		}
+	}
+	return msgs
+}
+
+// filterIncidents applies the q, status and impact query parameters, newest incidents first
+func filterIncidents(r *http.Request, ps *pageState) []*statuspage.Incident {
+	q := strings.ToLower(r.URL.Query().Get("q"))
+	status := r.URL.Query().Get("status")
+	impact := r.URL.Query().Get("impact")
+
+	return selectIncidents(ps, func(incident *statuspage.Incident) bool {
+		if q != "" && !strings.Contains(strings.ToLower(incident.Name), q) {
+			return false
+		}
+		if status != "" && incident.Status != status {
+			return false
+		}
+		if impact != "" && incident.Impact != impact {
+			return false
+		}
+		return true
+	})
+}
+
+// selectIncidents returns the incidents matching keep, newest first
+func selectIncidents(ps *pageState, keep func(*statuspage.Incident) bool) []*statuspage.Incident {
+	all := ps.incidents.list()
+	incidents := []*statuspage.Incident{}
+	for i := len(all) - 1; i >= 0; i-- {
+		if keep(all[i]) {
+			incidents = append(incidents, all[i])
+		}
+	}
+	return incidents
+}
+
+// isClosed reports whether an incident or maintenance has finished
+func isClosed(incident *statuspage.Incident) bool {
+	switch incident.Status {
+	case statuspage.IncidentStatusResolved, statuspage.IncidentStatusCompleted, "postmortem":
+		return true
+	default:
+		return false
+	}
+}
+
+func isUnresolved(incident *statuspage.Incident) bool {
+	return !isClosed(incident) && incident.Status != statuspage.IncidentStatusScheduled
+}
+
+func isScheduled(incident *statuspage.Incident) bool {
+	return incident.Status == statuspage.IncidentStatusScheduled
+}
+
+func isActiveMaintenance(incident *statuspage.Incident) bool {
+	return incident.Status == statuspage.IncidentStatusInProgress || incident.Status == statuspage.IncidentStatusVerifying
+}
+
+// componentSeverity orders component statuses from healthy to worst
+func componentSeverity(status string) int {
+	switch status {
+	case statuspage.ComponentStatusDegradedPerformance:
+		return 1
+	case statuspage.ComponentStatusPartialOutage:
+		return 2
+	case statuspage.ComponentStatusMajorOutage:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// impactForStatus maps the worst affected component status to an incident impact
+func impactForStatus(status string) string {
+	switch status {
+	case statuspage.ComponentStatusDegradedPerformance:
+		return statuspage.IncidentImpactMinor
+	case statuspage.ComponentStatusPartialOutage:
+		return statuspage.IncidentImpactMajor
+	case statuspage.ComponentStatusMajorOutage:
+		return statuspage.IncidentImpactCritical
+	default:
+		return statuspage.IncidentImpactNone
+	}
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
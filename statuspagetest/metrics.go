@@ -0,0 +1,169 @@
+package statuspagetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handleMetrics serves /pages/{page_id}/metrics and its sub-resources. New metrics are created through
+// handleMetricsProviders.
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, ps.metrics.list()))
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if len(rest) == 1 && rest[0] == "data" && r.Method == http.MethodPost {
+		s.addBulkMetricData(w, r, ps)
+		return
+	}
+
+	metric, ok := ps.metrics.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Metric not found")
+		return
+	}
+
+	if len(rest) == 2 && rest[1] == "data" {
+		s.handleMetricData(w, r, ps, metric)
+		return
+	}
+	if len(rest) > 1 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, metric)
+	case http.MethodPatch, http.MethodPut:
+		input, err := readInput(r, "metric")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		updated := *metric
+		if err := applyInput(&updated, without(input, metricInputOnly...)); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if updated.Name == "" {
+			writeError(w, http.StatusUnprocessableEntity, "Name can't be blank")
+			return
+		}
+		updated.ID = metric.ID
+		updated.PageID = metric.PageID
+		updated.CreatedAt = metric.CreatedAt
+		updated.UpdatedAt = s.now()
+		*metric = updated
+
+		writeJSON(w, http.StatusOK, metric)
+	case http.MethodDelete:
+		ps.metrics.remove(metric.ID)
+		delete(ps.metricData, metric.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// metricInputOnly lists attributes that clients may not set on a metric
+var metricInputOnly = []string{"id", "page_id", "metrics_provider_id", "created_at", "updated_at", "most_recent_data_at", "last_fetched_at"}
+
+// handleMetricData serves /pages/{page_id}/metrics/{metric_id}/data
+func (s *Server) handleMetricData(w http.ResponseWriter, r *http.Request, ps *pageState, metric *statuspage.Metric) {
+	switch r.Method {
+	case http.MethodGet:
+		from, _ := time.Parse(time.RFC3339, r.URL.Query().Get("from"))
+		to, _ := time.Parse(time.RFC3339, r.URL.Query().Get("to"))
+
+		points := []*statuspage.MetricData{}
+		for _, point := range ps.metricData[metric.ID] {
+			if !from.IsZero() && point.Timestamp.Before(from) {
+				continue
+			}
+			if !to.IsZero() && point.Timestamp.After(to) {
+				continue
+			}
+			points = append(points, point)
+		}
+		writeJSON(w, http.StatusOK, points)
+	case http.MethodPost:
+		input, err := readInput(r, "data")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		point := &statuspage.MetricData{}
+		if err := applyInput(point, input); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if point.Timestamp.IsZero() {
+			writeError(w, http.StatusUnprocessableEntity, "Timestamp can't be blank")
+			return
+		}
+
+		ps.metricData[metric.ID] = append(ps.metricData[metric.ID], point)
+		if metric.MostRecentDataAt == nil || point.Timestamp.After(*metric.MostRecentDataAt) {
+			ts := point.Timestamp
+			metric.MostRecentDataAt = &ts
+		}
+
+		writeJSON(w, http.StatusCreated, point)
+	case http.MethodDelete:
+		delete(ps.metricData, metric.ID)
+		metric.MostRecentDataAt = nil
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// addBulkMetricData serves POST /pages/{page_id}/metrics/data, which takes Unix timestamps keyed by metric ID
+func (s *Server) addBulkMetricData(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	var body struct {
+		Data map[string][]struct {
+			Timestamp int64   `json:"timestamp"`
+			Value     float64 `json:"value"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(body.Data) == 0 {
+		writeError(w, http.StatusBadRequest, "data is missing")
+		return
+	}
+	for metricID := range body.Data {
+		if _, ok := ps.metrics.get(metricID); !ok {
+			writeError(w, http.StatusNotFound, "Metric not found")
+			return
+		}
+	}
+
+	for metricID, points := range body.Data {
+		metric, _ := ps.metrics.get(metricID)
+		for _, p := range points {
+			point := &statuspage.MetricData{Timestamp: time.Unix(p.Timestamp, 0).UTC(), Value: p.Value}
+			ps.metricData[metricID] = append(ps.metricData[metricID], point)
+			if metric.MostRecentDataAt == nil || point.Timestamp.After(*metric.MostRecentDataAt) {
+				ts := point.Timestamp
+				metric.MostRecentDataAt = &ts
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusAccepted, body.Data)
+}
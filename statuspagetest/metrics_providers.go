@@ -0,0 +1,143 @@
+package statuspagetest
+
+import (
+	"net/http"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// metricsProviderInputOnly lists attributes that clients may not set on a metrics provider
+var metricsProviderInputOnly = []string{"id", "disabled", "last_revalidated_at", "created_at", "updated_at"}
+
+// handleMetricsProviders serves /pages/{page_id}/metrics_providers and its sub-resources. Metrics can only be
+// created through a provider, as in the real API.
+func (s *Server) handleMetricsProviders(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, ps.metricsProviders.list())
+		case http.MethodPost:
+			input, err := readInput(r, "metrics_provider")
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			provider := &statuspage.MetricProvider{}
+			if err := applyInput(provider, without(input, metricsProviderInputOnly...)); err != nil {
+				writeError(w, http.StatusUnprocessableEntity, err.Error())
+				return
+			}
+			if provider.Type == "" {
+				writeError(w, http.StatusUnprocessableEntity, "Type can't be blank")
+				return
+			}
+
+			now := s.now()
+			provider.ID = s.newID()
+			provider.CreatedAt = now
+			provider.UpdatedAt = now
+			ps.metricsProviders.add(provider.ID, provider)
+
+			writeJSON(w, http.StatusCreated, provider)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	provider, ok := ps.metricsProviders.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Metrics provider not found")
+		return
+	}
+
+	if len(rest) > 1 {
+		if len(rest) > 2 || rest[1] != "metrics" {
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+		s.handleProviderMetrics(w, r, ps, provider)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, provider)
+	case http.MethodPatch, http.MethodPut:
+		input, err := readInput(r, "metrics_provider")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		updated := *provider
+		if err := applyInput(&updated, without(input, metricsProviderInputOnly...)); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if updated.Type == "" {
+			writeError(w, http.StatusUnprocessableEntity, "Type can't be blank")
+			return
+		}
+		updated.ID = provider.ID
+		updated.CreatedAt = provider.CreatedAt
+		updated.UpdatedAt = s.now()
+		*provider = updated
+
+		writeJSON(w, http.StatusOK, provider)
+	case http.MethodDelete:
+		ps.metricsProviders.remove(provider.ID)
+		for _, metric := range ps.metrics.list() {
+			if metric.MetricsProviderID == provider.ID {
+				ps.metrics.remove(metric.ID)
+				delete(ps.metricData, metric.ID)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// handleProviderMetrics serves /pages/{page_id}/metrics_providers/{id}/metrics
+func (s *Server) handleProviderMetrics(w http.ResponseWriter, r *http.Request, ps *pageState, provider *statuspage.MetricProvider) {
+	switch r.Method {
+	case http.MethodGet:
+		metrics := []*statuspage.Metric{}
+		for _, metric := range ps.metrics.list() {
+			if metric.MetricsProviderID == provider.ID {
+				metrics = append(metrics, metric)
+			}
+		}
+		writeJSON(w, http.StatusOK, paginate(r, metrics))
+	case http.MethodPost:
+		input, err := readInput(r, "metric")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		metric := &statuspage.Metric{}
+		if err := applyInput(metric, without(input, metricInputOnly...)); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if metric.Name == "" {
+			writeError(w, http.StatusUnprocessableEntity, "Name can't be blank")
+			return
+		}
+
+		now := s.now()
+		metric.ID = s.newID()
+		metric.PageID = ps.page.ID
+		metric.MetricsProviderID = provider.ID
+		metric.CreatedAt = now
+		metric.UpdatedAt = now
+		ps.metrics.add(metric.ID, metric)
+
+		writeJSON(w, http.StatusCreated, metric)
+	default:
+		methodNotAllowed(w)
+	}
+}
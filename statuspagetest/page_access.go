@@ -0,0 +1,265 @@
+package statuspagetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handlePageAccessUsers serves /pages/{page_id}/page_access_users and its sub-resources
+func (s *Server) handlePageAccessUsers(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, ps.pageAccessUsers.list()))
+		case http.MethodPost:
+			input, err := readInput(r, "page_access_user")
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			user := &statuspage.PageAccessUser{}
+			if err := applyInput(user, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+				writeError(w, http.StatusUnprocessableEntity, err.Error())
+				return
+			}
+			if user.Email == "" {
+				writeError(w, http.StatusUnprocessableEntity, "Email can't be blank")
+				return
+			}
+
+			now := s.now()
+			user.ID = s.newID()
+			user.PageID = ps.page.ID
+			user.CreatedAt = now
+			user.UpdatedAt = now
+			ps.pageAccessUsers.add(user.ID, user)
+
+			writeJSON(w, http.StatusCreated, user)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	user, ok := ps.pageAccessUsers.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Page access user not found")
+		return
+	}
+
+	if len(rest) > 1 {
+		switch rest[1] {
+		case "components":
+			s.handleAccessList(w, r, rest[2:], &user.ComponentIDs, &user.UpdatedAt, user, componentLookup(ps))
+		case "metrics":
+			s.handleAccessList(w, r, rest[2:], &user.MetricIDs, &user.UpdatedAt, user, metricLookup(ps))
+		default:
+			writeError(w, http.StatusNotFound, "Not found")
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, user)
+	case http.MethodPatch, http.MethodPut:
+		input, err := readInput(r, "page_access_user")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		updated := *user
+		if err := applyInput(&updated, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		updated.ID = user.ID
+		updated.PageID = user.PageID
+		updated.CreatedAt = user.CreatedAt
+		updated.UpdatedAt = s.now()
+		*user = updated
+
+		writeJSON(w, http.StatusOK, user)
+	case http.MethodDelete:
+		ps.pageAccessUsers.remove(user.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// handlePageAccessGroups serves /pages/{page_id}/page_access_groups and its sub-resources
+func (s *Server) handlePageAccessGroups(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, ps.pageAccessGroups.list()))
+		case http.MethodPost:
+			input, err := readInput(r, "page_access_group")
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			group := &statuspage.PageAccessGroup{}
+			if err := applyInput(group, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+				writeError(w, http.StatusUnprocessableEntity, err.Error())
+				return
+			}
+			if group.Name == "" {
+				writeError(w, http.StatusUnprocessableEntity, "Name can't be blank")
+				return
+			}
+
+			now := s.now()
+			group.ID = s.newID()
+			group.PageID = ps.page.ID
+			group.CreatedAt = now
+			group.UpdatedAt = now
+			ps.pageAccessGroups.add(group.ID, group)
+
+			writeJSON(w, http.StatusCreated, group)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	group, ok := ps.pageAccessGroups.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Page access group not found")
+		return
+	}
+
+	if len(rest) > 1 {
+		if rest[1] != "components" {
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+		s.handleAccessList(w, r, rest[2:], &group.ComponentIDs, &group.UpdatedAt, group, componentLookup(ps))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodPatch, http.MethodPut:
+		input, err := readInput(r, "page_access_group")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		updated := *group
+		if err := applyInput(&updated, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		updated.ID = group.ID
+		updated.PageID = group.PageID
+		updated.CreatedAt = group.CreatedAt
+		updated.UpdatedAt = s.now()
+		*group = updated
+
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodDelete:
+		ps.pageAccessGroups.remove(group.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// handleAccessList serves the component or metric access list of a page access user or group. As in the real
+// API, PATCH and PUT add the listed IDs, POST replaces the list and DELETE removes the listed IDs, or every ID
+// when the request has no body. lookup resolves IDs to the resources returned by GET.
+func (s *Server) handleAccessList(w http.ResponseWriter, r *http.Request, rest []string, ids *[]string, updatedAt *time.Time, owner interface{}, lookup func(id string) (interface{}, bool)) {
+	if len(rest) > 1 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+	if len(rest) == 1 {
+		if r.Method != http.MethodDelete {
+			methodNotAllowed(w)
+			return
+		}
+		*ids = removeString(*ids, rest[0])
+		*updatedAt = s.now()
+		writeJSON(w, http.StatusOK, owner)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		items := []interface{}{}
+		for _, id := range *ids {
+			if item, ok := lookup(id); ok {
+				items = append(items, item)
+			}
+		}
+		writeJSON(w, http.StatusOK, paginate(r, items))
+		return
+	}
+
+	var body map[string][]string
+	if r.Method != http.MethodDelete || r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, "Malformed JSON body: "+err.Error())
+			return
+		}
+	}
+	listed, ok := body["component_ids"]
+	if !ok {
+		listed = body["metric_ids"]
+	}
+	for _, id := range listed {
+		if _, ok := lookup(id); !ok {
+			writeError(w, http.StatusNotFound, "Resource not found: "+id)
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodPatch, http.MethodPut:
+		for _, id := range listed {
+			if !containsString(*ids, id) {
+				*ids = append(*ids, id)
+			}
+		}
+	case http.MethodPost:
+		*ids = append([]string{}, listed...)
+	case http.MethodDelete:
+		if body == nil {
+			*ids = nil
+		}
+		for _, id := range listed {
+			*ids = removeString(*ids, id)
+		}
+	default:
+		methodNotAllowed(w)
+		return
+	}
+
+	*updatedAt = s.now()
+	writeJSON(w, http.StatusOK, owner)
+}
+
+// componentLookup returns a lookup of the components of a page
+func componentLookup(ps *pageState) func(id string) (interface{}, bool) {
+	return func(id string) (interface{}, bool) {
+		component, ok := ps.components.get(id)
+		return component, ok
+	}
+}
+
+// metricLookup returns a lookup of the metrics of a page
+func metricLookup(ps *pageState) func(id string) (interface{}, bool) {
+	return func(id string) (interface{}, bool) {
+		metric, ok := ps.metrics.get(id)
+		return metric, ok
+	}
+}
@@ -0,0 +1,49 @@
+package statuspagetest
+
+import (
+	"net/http"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handlePages serves /pages
+func (s *Server) handlePages(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	pages := []*statuspage.Page{}
+	for _, ps := range s.pages.list() {
+		pages = append(pages, ps.page)
+	}
+	writeJSON(w, http.StatusOK, pages)
+}
+
+// handlePage serves /pages/{page_id}
+func (s *Server) handlePage(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, ps.page)
+	case http.MethodPatch, http.MethodPut:
+		input, err := readInput(r, "page")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page := *ps.page
+		if err := applyInput(&page, without(input, "id", "created_at")); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		page.ID = ps.page.ID
+		page.CreatedAt = ps.page.CreatedAt
+		page.UpdatedAt = statuspage.Time{Time: s.now()}
+		*ps.page = page
+
+		writeJSON(w, http.StatusOK, ps.page)
+	default:
+		methodNotAllowed(w)
+	}
+}
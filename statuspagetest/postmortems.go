@@ -0,0 +1,112 @@
+package statuspagetest
+
+import (
+	"net/http"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handlePostmortem serves /pages/{page_id}/incidents/{incident_id}/postmortem and its actions
+func (s *Server) handlePostmortem(w http.ResponseWriter, r *http.Request, ps *pageState, incident *statuspage.Incident, rest []string) {
+	postmortem, exists := ps.postmortems[incident.ID]
+	now := statuspage.Time{Time: s.now()}
+
+	if len(rest) == 1 && r.Method == http.MethodPut {
+		if !exists {
+			writeError(w, http.StatusNotFound, "Postmortem not found")
+			return
+		}
+
+		switch rest[0] {
+		case "publish":
+			input, err := readInput(r, "postmortem")
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if postmortem.BodyDraft == "" {
+				writeError(w, http.StatusBadRequest, "Postmortem draft can't be blank")
+				return
+			}
+
+			decodeAttr(input, "notify_subscribers", &postmortem.NotifySubscribers)
+			decodeAttr(input, "notify_twitter", &postmortem.NotifyTwitter)
+			decodeAttr(input, "custom_tweet", &postmortem.CustomTweet)
+			postmortem.Body = postmortem.BodyDraft
+			postmortem.BodyUpdatedAt = &now
+			postmortem.PublishedAt = &now
+		case "revert":
+			if postmortem.PublishedAt == nil {
+				writeError(w, http.StatusBadRequest, "Postmortem is not published")
+				return
+			}
+			postmortem.Body = ""
+			postmortem.BodyUpdatedAt = nil
+			postmortem.PublishedAt = nil
+		default:
+			writeError(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		postmortem.UpdatedAt = now
+		syncIncidentPostmortem(incident, postmortem)
+		writeJSON(w, http.StatusOK, postmortem)
+		return
+	}
+	if len(rest) > 0 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Postmortem not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, postmortem)
+	case http.MethodPut:
+		input, err := readInput(r, "postmortem")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		draft := stringAttr(input, "body_draft")
+		if draft == "" {
+			writeError(w, http.StatusBadRequest, "Body draft can't be blank")
+			return
+		}
+
+		if !exists {
+			postmortem = &statuspage.Postmortem{
+				PreviewKey: s.newID(),
+				CreatedAt:  now,
+			}
+			ps.postmortems[incident.ID] = postmortem
+		}
+		postmortem.BodyDraft = draft
+		postmortem.BodyDraftUpdatedAt = &now
+		postmortem.UpdatedAt = now
+
+		writeJSON(w, http.StatusOK, postmortem)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Postmortem not found")
+			return
+		}
+		delete(ps.postmortems, incident.ID)
+		syncIncidentPostmortem(incident, &statuspage.Postmortem{})
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// syncIncidentPostmortem mirrors the published postmortem onto the incident attributes
+func syncIncidentPostmortem(incident *statuspage.Incident, postmortem *statuspage.Postmortem) {
+	incident.PostmortemBody = postmortem.Body
+	incident.PostmortemBodyLastUpdatedAt = postmortem.BodyUpdatedAt
+	incident.PostmortemPublishedAt = postmortem.PublishedAt
+	incident.PostmortemNotifiedSubscribers = postmortem.PublishedAt != nil && postmortem.NotifySubscribers
+	incident.PostmortemNotifiedTwitter = postmortem.PublishedAt != nil && postmortem.NotifyTwitter
+}
@@ -0,0 +1,148 @@
+package statuspagetest
+
+import (
+	"net/http"
+	"strings"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// publicStatusDescriptions maps status indicators to the descriptions shown on public pages
+var publicStatusDescriptions = map[string]string{
+	statuspage.StatusIndicatorNone:        "All Systems Operational",
+	statuspage.StatusIndicatorMinor:       "Minor Service Outage",
+	statuspage.StatusIndicatorMajor:       "Partial System Outage",
+	statuspage.StatusIndicatorCritical:    "Major System Outage",
+	statuspage.StatusIndicatorMaintenance: "Service Under Maintenance",
+}
+
+// PublicURL returns the URL of a page's public status API, for use with statuspage.NewPublicClient
+func (s *Server) PublicURL(pageID string) string {
+	return s.URL + "/public/" + pageID
+}
+
+// servePublic serves /public/{page_id}/api/v2/*.json without authentication
+func (s *Server) servePublic(w http.ResponseWriter, r *http.Request, segments []string) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	if len(segments) < 4 || segments[2] != "api" || segments[3] != "v2" {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps, ok := s.pages.get(segments[1])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Page not found")
+		return
+	}
+
+	page := map[string]interface{}{
+		"id":         ps.page.ID,
+		"name":       ps.page.Name,
+		"url":        s.PublicURL(ps.page.ID),
+		"time_zone":  ps.page.TimeZone,
+		"updated_at": ps.page.UpdatedAt,
+	}
+	maintenances := selectIncidents(ps, func(incident *statuspage.Incident) bool {
+		return isScheduled(incident) || isActiveMaintenance(incident)
+	})
+
+	switch strings.Join(segments[4:], "/") {
+	case "summary.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"page":                   page,
+			"status":                 publicStatus(ps),
+			"components":             publicComponents(ps),
+			"incidents":              selectIncidents(ps, isPublicUnresolved),
+			"scheduled_maintenances": maintenances,
+		})
+	case "status.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"page": page, "status": publicStatus(ps)})
+	case "components.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"page": page, "components": publicComponents(ps)})
+	case "incidents.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"page": page, "incidents": selectIncidents(ps, func(incident *statuspage.Incident) bool {
+			return !isScheduled(incident) && !isActiveMaintenance(incident)
+		})})
+	case "incidents/unresolved.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"page": page, "incidents": selectIncidents(ps, isPublicUnresolved)})
+	case "scheduled-maintenances.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"page": page, "scheduled_maintenances": selectIncidents(ps, func(incident *statuspage.Incident) bool {
+			return incident.ScheduledFor != nil
+		})})
+	case "scheduled-maintenances/upcoming.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"page": page, "scheduled_maintenances": selectIncidents(ps, isScheduled)})
+	case "scheduled-maintenances/active.json":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"page": page, "scheduled_maintenances": selectIncidents(ps, isActiveMaintenance)})
+	default:
+		writeError(w, http.StatusNotFound, "Not found")
+	}
+}
+
+// isPublicUnresolved reports whether an incident is listed as unresolved on the public page, which leaves
+// out maintenances
+func isPublicUnresolved(incident *statuspage.Incident) bool {
+	return isUnresolved(incident) && !isActiveMaintenance(incident)
+}
+
+// publicComponents lists components followed by component groups, which the public API reports as
+// components with group set
+func publicComponents(ps *pageState) []*statuspage.Component {
+	components := append([]*statuspage.Component{}, ps.components.list()...)
+	for _, group := range ps.componentGroups.list() {
+		components = append(components, &statuspage.Component{
+			ID:          group.ID,
+			PageID:      group.PageID,
+			Name:        group.Name,
+			Description: group.Description,
+			Position:    group.Position,
+			Status:      groupStatus(ps, group),
+			Group:       true,
+			Components:  group.Components,
+			CreatedAt:   group.CreatedAt,
+			UpdatedAt:   group.UpdatedAt,
+		})
+	}
+	return components
+}
+
+// groupStatus returns the most severe status among a group's components
+func groupStatus(ps *pageState, group *statuspage.ComponentGroup) string {
+	status := statuspage.ComponentStatusOperational
+	for _, id := range group.Components {
+		if component, ok := ps.components.get(id); ok && componentSeverity(component.Status) > componentSeverity(status) {
+			status = component.Status
+		}
+	}
+	return status
+}
+
+// publicStatus rolls the impact of unresolved incidents up into the page status indicator
+func publicStatus(ps *pageState) statuspage.PublicStatus {
+	indicator := statuspage.StatusIndicatorNone
+	severity := map[string]int{
+		statuspage.StatusIndicatorNone:        0,
+		statuspage.StatusIndicatorMaintenance: 1,
+		statuspage.StatusIndicatorMinor:       2,
+		statuspage.StatusIndicatorMajor:       3,
+		statuspage.StatusIndicatorCritical:    4,
+	}
+	for _, incident := range ps.incidents.list() {
+		impact := incident.Impact
+		switch {
+		case isActiveMaintenance(incident):
+			impact = statuspage.StatusIndicatorMaintenance
+		case !isPublicUnresolved(incident):
+			continue
+		}
+		if severity[impact] > severity[indicator] {
+			indicator = impact
+		}
+	}
+	return statuspage.PublicStatus{Indicator: indicator, Description: publicStatusDescriptions[indicator]}
+}
@@ -0,0 +1,306 @@
+package statuspagetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// redacted replaces the API key wherever it appears in recorded traffic
+const redacted = "REDACTED"
+
+// ErrUnmatchedRequest is returned by a replaying Recorder when no recorded interaction matches a request
+var ErrUnmatchedRequest = errors.New("statuspagetest: no recorded interaction matches request")
+
+// RecorderMode selects whether a Recorder captures live traffic or replays a cassette
+type RecorderMode int
+
+const (
+	// ModeReplay serves responses from the cassette and never touches the network
+	ModeReplay RecorderMode = iota
+	// ModeRecord forwards requests to the real transport and captures every interaction
+	ModeRecord
+)
+
+// Cassette is the on-disk format holding recorded interactions in the order they happened
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the captured form of an outgoing request with credentials redacted
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the captured form of a response
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records Statuspage traffic to a cassette file or replays it offline.
+// Plug it into the client with statuspage.WithHTTPClient(recorder.Client()).
+type Recorder struct {
+	mode      RecorderMode
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	played   []bool
+}
+
+// NewRecorder creates a Recorder backed by the cassette at path. In replay mode the cassette must exist;
+// in record mode any existing cassette is replaced when Save is called.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		transport: http.DefaultTransport,
+		cassette:  &Cassette{},
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, r.cassette); err != nil {
+			return nil, fmt.Errorf("statuspagetest: decode cassette %s: %w", path, err)
+		}
+		r.played = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// SetTransport sets the transport used to reach the real API in record mode
+func (r *Recorder) SetTransport(transport http.RoundTripper) {
+	r.transport = transport
+}
+
+// Client returns an HTTP client that sends every request through the Recorder
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper. Like any RoundTripper it leaves req unmodified.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, out, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Headers: req.Header.Clone(),
+		Body:    string(body),
+	}
+	redactRequest(&recorded, apiKeyFromHeader(req.Header))
+
+	if r.mode == ModeRecord {
+		return r.record(out, recorded)
+	}
+	if out.Body != nil {
+		out.Body.Close()
+	}
+	return r.replay(req, recorded)
+}
+
+// record forwards the request to the real transport and stores the resulting interaction
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header.Clone(),
+			Body:       string(body),
+		},
+	})
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// replay serves the first unplayed interaction matching the request
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.played[i] = true
+
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s %s", ErrUnmatchedRequest, recorded.Method, recorded.URL, recorded.Body)
+}
+
+// Save writes the recorded interactions to the cassette file. It is a no-op in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data, 0o644)
+}
+
+// Unplayed returns the recorded interactions that have not been replayed yet
+func (r *Recorder) Unplayed() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unplayed []*Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if i < len(r.played) && !r.played[i] {
+			unplayed = append(unplayed, interaction)
+		}
+	}
+	return unplayed
+}
+
+// readRequestBody returns the request body and the request to send on. The body is read through GetBody
+// when possible, leaving req untouched; otherwise req.Body is consumed and a clone carrying a copy is returned.
+func readRequestBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, req, nil
+	}
+
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer rc.Close()
+		body, err := io.ReadAll(rc)
+		if err != nil {
+			return nil, nil, err
+		}
+		return body, req, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	return body, out, nil
+}
+
+// apiKeyFromHeader extracts the API key from an "OAuth <key>" Authorization header
+func apiKeyFromHeader(h http.Header) string {
+	auth := h.Get("Authorization")
+	if !strings.HasPrefix(auth, "OAuth ") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(auth, "OAuth "))
+}
+
+// redactRequest removes the API key from the recorded headers, URL and body
+func redactRequest(req *RecordedRequest, apiKey string) {
+	if req.Headers.Get("Authorization") != "" {
+		req.Headers.Set("Authorization", "OAuth "+redacted)
+	}
+	if apiKey == "" {
+		return
+	}
+	req.URL = strings.ReplaceAll(req.URL, apiKey, redacted)
+	req.Body = strings.ReplaceAll(req.Body, apiKey, redacted)
+}
+
+// matches reports whether a recorded request matches an incoming one by method, path, query and body.
+// The host is ignored so cassettes replay against any base URL.
+func matches(recorded, incoming RecordedRequest) bool {
+	if recorded.Method != incoming.Method {
+		return false
+	}
+	if requestTarget(recorded.URL) != requestTarget(incoming.URL) {
+		return false
+	}
+	return sameBody(recorded.Body, incoming.Body)
+}
+
+// requestTarget returns the path and normalized query of a URL
+func requestTarget(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.EscapedPath() + "?" + u.Query().Encode()
+}
+
+// sameBody compares request bodies, treating semantically equal JSON documents as equal
+func sameBody(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var av, bv interface{}
+	if json.Unmarshal([]byte(a), &av) != nil || json.Unmarshal([]byte(b), &bv) != nil {
+		return false
+	}
+	an, _ := json.Marshal(av)
+	bn, _ := json.Marshal(bv)
+	return bytes.Equal(an, bn)
+}
@@ -0,0 +1,432 @@
+// Package statuspagetest provides an in-memory fake of the Statuspage management API for tests.
+//
+// The fake keeps pages, components, component groups, incidents, incident updates, postmortems,
+// subscribers, metrics and their providers, incident templates, status embed settings and page access
+// users and groups in memory and speaks the same JSON shapes as the statuspage package, so a client
+// pointed at it works end-to-end without network access:
+//
+//	fake := statuspagetest.NewServer()
+//	defer fake.Close()
+//	page := fake.AddPage(statuspage.Page{Name: "Acme"})
+//	client := statuspage.NewClient("test-key", statuspage.WithBaseURL(fake.URL))
+//
+// It also provides Recorder, an http.RoundTripper that captures real API traffic to cassette files and
+// replays it deterministically in later test runs, and recorded webhook notifications such as
+// ComponentUpdateWebhook for exercising statuspage.WebhookHandler.
+package statuspagetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// defaultPerPage is the page size used when a list request does not specify per_page
+const defaultPerPage = 100
+
+// Server is a stateful fake Statuspage API served over HTTP
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	nextID int64
+	pages  *collection[pageState]
+}
+
+// pageState holds every resource that belongs to a single status page
+type pageState struct {
+	page            *statuspage.Page
+	components      *collection[statuspage.Component]
+	componentGroups *collection[statuspage.ComponentGroup]
+	incidents       *collection[statuspage.Incident]
+	postmortems     map[string]*statuspage.Postmortem
+	subscribers     *collection[statuspage.Subscriber]
+	metrics         *collection[statuspage.Metric]
+	metricData      map[string][]*statuspage.MetricData
+	templates       *collection[statuspage.Template]
+	embedConfig     *statuspage.StatusEmbedConfig
+
+	metricsProviders *collection[statuspage.MetricProvider]
+	pageAccessUsers  *collection[statuspage.PageAccessUser]
+	pageAccessGroups *collection[statuspage.PageAccessGroup]
+
+	// incidentSubscribers holds the subscribers of each incident, keyed by incident ID
+	incidentSubscribers map[string]*collection[statuspage.Subscriber]
+}
+
+// NewServer starts a fake Statuspage API with no pages. Callers must Close it when done.
+func NewServer() *Server {
+	s := &Server{pages: newCollection[pageState]()}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// AddPage seeds a status page and returns the stored copy. An ID is generated when page.ID is empty.
+func (s *Server) AddPage(page statuspage.Page) *statuspage.Page {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if page.ID == "" {
+		page.ID = s.newID()
+	}
+	now := s.now()
+	if page.CreatedAt.IsZero() {
+		page.CreatedAt = statuspage.Time{Time: now}
+	}
+	page.UpdatedAt = statuspage.Time{Time: now}
+
+	p := &page
+	s.pages.add(p.ID, &pageState{
+		page:            p,
+		components:      newCollection[statuspage.Component](),
+		componentGroups: newCollection[statuspage.ComponentGroup](),
+		incidents:       newCollection[statuspage.Incident](),
+		postmortems:     map[string]*statuspage.Postmortem{},
+		subscribers:     newCollection[statuspage.Subscriber](),
+		metrics:         newCollection[statuspage.Metric](),
+		metricData:      map[string][]*statuspage.MetricData{},
+		templates:       newCollection[statuspage.Template](),
+		embedConfig:     defaultEmbedConfig(p.ID, now),
+
+		metricsProviders: newCollection[statuspage.MetricProvider](),
+		pageAccessUsers:  newCollection[statuspage.PageAccessUser](),
+		pageAccessGroups: newCollection[statuspage.PageAccessGroup](),
+
+		incidentSubscribers: map[string]*collection[statuspage.Subscriber]{},
+	})
+
+	copied := *p
+	return &copied
+}
+
+// serveHTTP authenticates the request and routes it to the matching resource handler. The public status
+// API is served without authentication.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, "/public/") {
+		s.servePublic(w, r, strings.Split(strings.Trim(r.URL.Path, "/"), "/"))
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "OAuth ") {
+		writeError(w, http.StatusUnauthorized, "Could not authenticate")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) == 0 || segments[0] != "pages" {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(segments) == 1 {
+		s.handlePages(w, r)
+		return
+	}
+
+	ps, ok := s.pages.get(segments[1])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Page not found")
+		return
+	}
+
+	if len(segments) == 2 {
+		s.handlePage(w, r, ps)
+		return
+	}
+
+	rest := segments[3:]
+	switch segments[2] {
+	case "components":
+		s.handleComponents(w, r, ps, rest)
+	case "component-groups":
+		s.handleComponentGroups(w, r, ps, rest)
+	case "incidents":
+		s.handleIncidents(w, r, ps, rest)
+	case "subscribers":
+		s.handleSubscribers(w, r, ps, rest)
+	case "metrics":
+		s.handleMetrics(w, r, ps, rest)
+	case "metrics_providers":
+		s.handleMetricsProviders(w, r, ps, rest)
+	case "incident_templates":
+		s.handleTemplates(w, r, ps, rest)
+	case "status_embed_config":
+		s.handleStatusEmbedConfig(w, r, ps, rest)
+	case "page_access_users":
+		s.handlePageAccessUsers(w, r, ps, rest)
+	case "page_access_groups":
+		s.handlePageAccessGroups(w, r, ps, rest)
+	default:
+		writeError(w, http.StatusNotFound, "Not found")
+	}
+}
+
+// newID returns a unique identifier in the style of Statuspage object IDs
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("%012s", strconv.FormatInt(s.nextID, 36))
+}
+
+// now returns the timestamp recorded on created and updated resources
+func (s *Server) now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// collection is an insertion-ordered set of resources keyed by ID
+type collection[T any] struct {
+	order []string
+	items map[string]*T
+}
+
+func newCollection[T any]() *collection[T] {
+	return &collection[T]{items: map[string]*T{}}
+}
+
+func (c *collection[T]) add(id string, item *T) {
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.items[id] = item
+}
+
+func (c *collection[T]) get(id string) (*T, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+func (c *collection[T]) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+	delete(c.items, id)
+	for i, existing := range c.order {
+		if existing == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (c *collection[T]) list() []*T {
+	items := make([]*T, 0, len(c.order))
+	for _, id := range c.order {
+		items = append(items, c.items[id])
+	}
+	return items
+}
+
+// paginate returns the slice of items selected by the page and per_page query parameters
+func paginate[T any](r *http.Request, items []T) []T {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		return []T{}
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+// replaceRule lists the attributes a PUT request may send for a resource and the enums among them, which
+// cannot be cleared
+type replaceRule struct {
+	attributes []string
+	enums      []string
+}
+
+// replaceRules holds the replaceRule of every resource that supports PUT, keyed by its request key
+var replaceRules = map[string]replaceRule{
+	"page": {attributes: []string{
+		"name", "domain", "url", "subdomain", "branding", "css_body_background_color", "css_font_color",
+		"css_light_font_color", "css_greens", "css_yellows", "css_oranges", "css_blues", "css_reds",
+		"css_border_color", "css_graph_color", "css_link_color", "css_no_data", "hidden_from_search",
+		"viewers_must_be_team_members", "allow_page_subscribers", "allow_incident_subscribers",
+		"allow_email_subscribers", "allow_sms_subscribers", "allow_rss_atom_feeds", "allow_webhook_subscribers",
+		"notifications_from_email", "notifications_email_footer", "time_zone", "city", "state", "country",
+		"twitter_username", "page_description", "headline", "support_url", "ip_restrictions", "favicon_logo",
+		"transactional_logo", "hero_cover", "email_logo", "twitter_logo",
+	}},
+	"component": {
+		attributes: []string{"name", "description", "status", "only_show_if_degraded", "group_id", "showcase", "start_date"},
+		enums:      []string{"status"},
+	},
+	"component_group": {attributes: []string{"name", "description", "components", "position"}},
+	"incident": {
+		attributes: []string{
+			"name", "status", "impact_override", "scheduled_for", "scheduled_until", "scheduled_remind_prior",
+			"scheduled_auto_in_progress", "scheduled_auto_completed", "body", "component_ids", "components",
+			"deliver_notifications", "auto_transition_deliver_notifications_at_end",
+			"auto_transition_deliver_notifications_at_start", "auto_transition_to_maintenance_state",
+			"auto_transition_to_operational_state", "auto_tweet_at_beginning", "auto_tweet_on_completion",
+			"auto_tweet_on_creation", "auto_tweet_one_hour_before", "backfill_date", "backfilled", "metadata",
+		},
+		enums: []string{"status"},
+	},
+	"incident_update": {
+		attributes: []string{"body", "status", "deliver_notifications", "custom_tweet", "tweet_id", "components", "affected_components"},
+		enums:      []string{"status"},
+	},
+	"metric": {
+		attributes: []string{"name", "suffix", "y_axis_min", "y_axis_max", "y_axis_hidden", "transform", "decimal_places", "tooltip", "display_name"},
+		enums:      []string{"transform"},
+	},
+	"metrics_provider": {
+		attributes: []string{"type", "email", "password", "api_key", "api_token", "application_key", "metric_base_uri"},
+		enums:      []string{"type"},
+	},
+	"page_access_user": {attributes: []string{"email", "external_login", "page_access_group_ids", "component_ids", "metric_ids"}},
+	"page_access_group": {attributes: []string{
+		"name", "description", "color", "component_ids", "metric_ids", "page_access_user_ids", "external_identifier",
+	}},
+	"status_embed_config": {
+		attributes: []string{
+			"position", "incident_background_color", "incident_text_color", "maintenance_background_color",
+			"maintenance_text_color",
+		},
+		enums: []string{"position"},
+	},
+}
+
+// readInput decodes a request body of the form {"<key>": {...}} and returns the wrapped object. The attributes
+// of PUT requests are checked against the replaceRule of the resource.
+func readInput(r *http.Request, key string) (map[string]json.RawMessage, error) {
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("Malformed JSON body: %v", err)
+	}
+
+	var input map[string]json.RawMessage
+	if err := json.Unmarshal(body[key], &input); err != nil || input == nil {
+		return nil, fmt.Errorf("Missing %s parameter", key)
+	}
+
+	if rule, ok := replaceRules[key]; ok && r.Method == http.MethodPut {
+		if err := rule.check(input); err != nil {
+			return nil, err
+		}
+	}
+	return input, nil
+}
+
+// check rejects attributes the resource does not accept and enums sent empty or null
+func (rule replaceRule) check(input map[string]json.RawMessage) error {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !containsString(rule.attributes, key) {
+			return fmt.Errorf("Unpermitted parameter: %s", key)
+		}
+		if containsString(rule.enums, key) {
+			if value := string(input[key]); value == "null" || value == `""` {
+				return fmt.Errorf("%s can't be blank", key)
+			}
+		}
+	}
+	return nil
+}
+
+// applyInput merges the attributes present in input into v, clearing attributes sent as null
+func applyInput(v interface{}, input map[string]json.RawMessage) error {
+	current, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(current, &fields); err != nil {
+		return err
+	}
+	for key, value := range input {
+		if string(value) == "null" {
+			delete(fields, key)
+			continue
+		}
+		fields[key] = value
+	}
+
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+	return json.Unmarshal(merged, v)
+}
+
+// without returns a copy of input with the given attributes removed
+func without(input map[string]json.RawMessage, keys ...string) map[string]json.RawMessage {
+	out := make(map[string]json.RawMessage, len(input))
+	for k, v := range input {
+		out[k] = v
+	}
+	for _, k := range keys {
+		delete(out, k)
+	}
+	return out
+}
+
+// stringAttr returns the string value of an input attribute, or an empty string when it is absent
+func stringAttr(input map[string]json.RawMessage, key string) string {
+	var s string
+	json.Unmarshal(input[key], &s)
+	return s
+}
+
+// decodeAttr decodes an input attribute into v, leaving v untouched when the attribute is absent
+func decodeAttr(input map[string]json.RawMessage, key string, v interface{}) error {
+	raw, ok := input[key]
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// writeJSON writes v as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error body in the format used by the Statuspage API
+func writeError(w http.ResponseWriter, code int, messages ...string) {
+	var body interface{} = messages
+	if len(messages) == 1 {
+		body = messages[0]
+	}
+	writeJSON(w, code, map[string]interface{}{"error": body})
+}
+
+// methodNotAllowed writes the response for an unsupported HTTP method
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+}
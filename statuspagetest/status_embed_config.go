@@ -0,0 +1,55 @@
+package statuspagetest
+
+import (
+	"net/http"
+	"time"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// defaultEmbedConfig returns the status embed settings of a new page
+func defaultEmbedConfig(pageID string, now time.Time) *statuspage.StatusEmbedConfig {
+	return &statuspage.StatusEmbedConfig{
+		PageID:                     pageID,
+		Position:                   "bottom-left",
+		IncidentBackgroundColor:    "#FFFFFF",
+		IncidentTextColor:          "#000000",
+		MaintenanceBackgroundColor: "#FFFFFF",
+		MaintenanceTextColor:       "#000000",
+		CreatedAt:                  now,
+		UpdatedAt:                  now,
+	}
+}
+
+// handleStatusEmbedConfig serves /pages/{page_id}/status_embed_config
+func (s *Server) handleStatusEmbedConfig(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) > 0 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, ps.embedConfig)
+	case http.MethodPatch, http.MethodPut:
+		input, err := readInput(r, "status_embed_config")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		updated := *ps.embedConfig
+		if err := applyInput(&updated, without(input, "page_id", "created_at", "updated_at")); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		updated.PageID = ps.embedConfig.PageID
+		updated.CreatedAt = ps.embedConfig.CreatedAt
+		updated.UpdatedAt = s.now()
+		*ps.embedConfig = updated
+
+		writeJSON(w, http.StatusOK, ps.embedConfig)
+	default:
+		methodNotAllowed(w)
+	}
+}
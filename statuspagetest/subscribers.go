@@ -0,0 +1,451 @@
+package statuspagetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handleSubscribers serves /pages/{page_id}/subscribers and its sub-resources
+func (s *Server) handleSubscribers(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listSubscribers(w, r, ps)
+		case http.MethodPost:
+			s.createSubscriber(w, r, ps)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if len(rest) == 1 && r.Method == http.MethodGet {
+		switch rest[0] {
+		case "count":
+			writeJSON(w, http.StatusOK, countSubscribers(ps, r.URL.Query().Get("type"), r.URL.Query().Get("state")))
+			return
+		case "histogram_by_state":
+			writeJSON(w, http.StatusOK, subscriberHistogram(ps))
+			return
+		case "unsubscribed":
+			var unsubscribed []*statuspage.Subscriber
+			for _, subscriber := range ps.subscribers.list() {
+				if subscriber.UnsubscribedAt != nil {
+					unsubscribed = append(unsubscribed, subscriber)
+				}
+			}
+			writeJSON(w, http.StatusOK, paginate(r, unsubscribed))
+			return
+		}
+	}
+	if len(rest) == 1 && r.Method == http.MethodPost {
+		switch rest[0] {
+		case "unsubscribe", "reactivate", "resend_confirmation":
+			s.bulkSubscribers(w, r, ps, rest[0])
+			return
+		}
+	}
+
+	subscriber, ok := ps.subscribers.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Subscriber not found")
+		return
+	}
+
+	if len(rest) == 2 && rest[1] == "resend_confirmation" && r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	if len(rest) > 1 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, subscriber)
+	case http.MethodPatch:
+		input, err := readInput(r, "subscriber")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var ids []string
+		if err := decodeAttr(input, "component_ids", &ids); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if _, ok := input["component_ids"]; ok {
+			subscriber.ComponentIDs = ids
+			subscriber.Components = componentsByID(ps, ids)
+		}
+
+		writeJSON(w, http.StatusOK, subscriber)
+	case http.MethodDelete:
+		now := s.now()
+		subscriber.UnsubscribedAt = &now
+		writeJSON(w, http.StatusOK, subscriber)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createSubscriber(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	subscriber, ok := s.newSubscriber(w, r, ps)
+	if !ok {
+		return
+	}
+	ps.subscribers.add(subscriber.ID, subscriber)
+
+	writeJSON(w, http.StatusCreated, subscriber)
+}
+
+// newSubscriber builds a subscriber from the request body, writing a validation error and returning false
+// when the input is rejected
+func (s *Server) newSubscriber(w http.ResponseWriter, r *http.Request, ps *pageState) (*statuspage.Subscriber, bool) {
+	input, err := readInput(r, "subscriber")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	subscriber := &statuspage.Subscriber{}
+	err = applyInput(subscriber, without(input, "id", "page_id", "created_at", "mode", "components",
+		"confirmed_at", "unsubscribed_at", "quarantined", "quarantined_at", "purge_at"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return nil, false
+	}
+
+	switch {
+	case subscriber.Email != "":
+		subscriber.Mode = "email"
+	case subscriber.Endpoint != "":
+		subscriber.Mode = "webhook"
+	case subscriber.PhoneNumber != "":
+		subscriber.Mode = "sms"
+	default:
+		writeError(w, http.StatusUnprocessableEntity, "Email, endpoint or phone number must be provided")
+		return nil, false
+	}
+	if subscriber.Mode == "webhook" && subscriber.Email == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Email can't be blank for webhook subscribers")
+		return nil, false
+	}
+
+	now := s.now()
+	subscriber.ID = s.newID()
+	subscriber.PageID = ps.page.ID
+	subscriber.CreatedAt = now
+	subscriber.Components = componentsByID(ps, subscriber.ComponentIDs)
+	if subscriber.SkipConfirmationNotification {
+		subscriber.ConfirmedAt = &now
+	}
+	return subscriber, true
+}
+
+// handleIncidentSubscribers serves /pages/{page_id}/incidents/{incident_id}/subscribers and its sub-resources
+func (s *Server) handleIncidentSubscribers(w http.ResponseWriter, r *http.Request, ps *pageState, incident *statuspage.Incident, rest []string) {
+	subscribers, ok := ps.incidentSubscribers[incident.ID]
+	if !ok {
+		subscribers = newCollection[statuspage.Subscriber]()
+		ps.incidentSubscribers[incident.ID] = subscribers
+	}
+
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, subscribers.list()))
+		case http.MethodPost:
+			subscriber, ok := s.newSubscriber(w, r, ps)
+			if !ok {
+				return
+			}
+			if subscriber.Mode == "webhook" {
+				writeError(w, http.StatusBadRequest, "Incident subscribers must have an email or phone number")
+				return
+			}
+			subscribers.add(subscriber.ID, subscriber)
+			writeJSON(w, http.StatusCreated, subscriber)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	subscriber, ok := subscribers.get(rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Subscriber not found")
+		return
+	}
+
+	if len(rest) == 2 && rest[1] == "resend_confirmation" && r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	if len(rest) > 1 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, subscriber)
+	case http.MethodDelete:
+		subscribers.remove(subscriber.ID)
+		writeJSON(w, http.StatusOK, subscriber)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// bulkSubscribers applies an unsubscribe, reactivate or resend_confirmation action to the subscribers
+// selected by the request body. As in the real API, resend_confirmation accepts nothing but subscribers.
+func (s *Server) bulkSubscribers(w http.ResponseWriter, r *http.Request, ps *pageState, action string) {
+	var input map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if action == "resend_confirmation" {
+		for key := range input {
+			if key != "subscribers" {
+				writeError(w, http.StatusBadRequest, "Unpermitted parameter: "+key)
+				return
+			}
+		}
+	}
+
+	var selected []*statuspage.Subscriber
+	var all string
+	var ids []string
+	switch {
+	case json.Unmarshal(input["subscribers"], &all) == nil && all == "all":
+		selected = ps.subscribers.list()
+	case json.Unmarshal(input["subscribers"], &ids) == nil && len(ids) > 0:
+		for _, id := range ids {
+			subscriber, ok := ps.subscribers.get(id)
+			if !ok {
+				writeError(w, http.StatusNotFound, "Subscriber not found")
+				return
+			}
+			selected = append(selected, subscriber)
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "subscribers is missing")
+		return
+	}
+
+	// Each action only applies to subscribers in the state it changes, mirroring the API defaults
+	subscriberType := stringAttr(input, "type")
+	state := stringAttr(input, "state")
+	switch action {
+	case "reactivate":
+		state = statuspage.SubscriberStateQuarantined
+	case "resend_confirmation":
+		state = statuspage.SubscriberStateUnconfirmed
+	case "unsubscribe":
+		if state == "" {
+			state = statuspage.SubscriberStateActive
+		}
+	}
+
+	now := s.now()
+	for _, subscriber := range selected {
+		if subscriber.UnsubscribedAt != nil || !matchesSubscriber(subscriber, subscriberType, state) {
+			continue
+		}
+		switch action {
+		case "unsubscribe":
+			subscriber.UnsubscribedAt = &now
+		case "reactivate":
+			subscriber.Quarantined = false
+			subscriber.QuarantinedAt = nil
+		}
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// subscriberState reports whether a subscriber is active, unconfirmed or quarantined
+func subscriberState(subscriber *statuspage.Subscriber) string {
+	switch {
+	case subscriber.Quarantined:
+		return statuspage.SubscriberStateQuarantined
+	case subscriber.ConfirmedAt == nil:
+		return statuspage.SubscriberStateUnconfirmed
+	default:
+		return statuspage.SubscriberStateActive
+	}
+}
+
+// matchesSubscriber reports whether a subscriber has the given type and state. Empty filters and the
+// "all" state match every subscriber.
+func matchesSubscriber(subscriber *statuspage.Subscriber, subscriberType, state string) bool {
+	if subscriberType != "" && subscriber.Mode != subscriberType {
+		return false
+	}
+	return state == "" || state == statuspage.SubscriberStateAll || subscriberState(subscriber) == state
+}
+
+// listSubscribers serves the subscriber list, which pages with a zero-based page and a limit instead of
+// per_page
+func (s *Server) listSubscribers(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	query := r.URL.Query()
+	subscribers := filterSubscribers(r, ps)
+
+	field := query.Get("sort_field")
+	if field == "" {
+		field = statuspage.SubscriberSortPrimary
+	}
+	descending := query.Get("sort_direction") == statuspage.SortDescending
+	sort.SliceStable(subscribers, func(i, j int) bool {
+		a, b := subscribers[i], subscribers[j]
+		if descending {
+			a, b = b, a
+		}
+		switch field {
+		case statuspage.SubscriberSortCreatedAt:
+			return a.CreatedAt.Before(b.CreatedAt)
+		case statuspage.SubscriberSortQuarantinedAt:
+			return a.QuarantinedAt != nil && (b.QuarantinedAt == nil || a.QuarantinedAt.Before(*b.QuarantinedAt))
+		case statuspage.SubscriberSortRelevance:
+			return false
+		default:
+			return subscriberContact(a) < subscriberContact(b)
+		}
+	})
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page < 0 {
+		page = 0
+	}
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit < 1 {
+		limit = defaultPerPage
+	}
+
+	start := page * limit
+	if start >= len(subscribers) {
+		writeJSON(w, http.StatusOK, []*statuspage.Subscriber{})
+		return
+	}
+	end := start + limit
+	if end > len(subscribers) {
+		end = len(subscribers)
+	}
+	writeJSON(w, http.StatusOK, subscribers[start:end])
+}
+
+// filterSubscribers returns subscribers matching the q, type and state query parameters. Unsubscribed
+// subscribers are never included and state defaults to active.
+func filterSubscribers(r *http.Request, ps *pageState) []*statuspage.Subscriber {
+	query := r.URL.Query()
+	q := strings.ToLower(query.Get("q"))
+	state := query.Get("state")
+	if state == "" {
+		state = statuspage.SubscriberStateActive
+	}
+
+	subscribers := []*statuspage.Subscriber{}
+	for _, subscriber := range ps.subscribers.list() {
+		if subscriber.UnsubscribedAt != nil || !matchesSubscriber(subscriber, query.Get("type"), state) {
+			continue
+		}
+		if q != "" && !strings.Contains(strings.ToLower(subscriberContact(subscriber)), q) {
+			continue
+		}
+		subscribers = append(subscribers, subscriber)
+	}
+	return subscribers
+}
+
+// subscriberContact returns the contact information the API searches and sorts subscribers by
+func subscriberContact(subscriber *statuspage.Subscriber) string {
+	return subscriber.Email + " " + subscriber.Endpoint + " " + subscriber.PhoneNumber
+}
+
+// countSubscribers counts subscribed subscribers by type. State defaults to active.
+func countSubscribers(ps *pageState, subscriberType, state string) *statuspage.SubscriberCountByType {
+	if state == "" {
+		state = statuspage.SubscriberStateActive
+	}
+
+	count := &statuspage.SubscriberCountByType{}
+	for _, subscriber := range ps.subscribers.list() {
+		if subscriber.UnsubscribedAt != nil || !matchesSubscriber(subscriber, subscriberType, state) {
+			continue
+		}
+		switch subscriber.Mode {
+		case statuspage.SubscriberTypeEmail:
+			count.Email++
+		case statuspage.SubscriberTypeSMS:
+			count.SMS++
+		case statuspage.SubscriberTypeWebhook:
+			count.Webhook++
+		case statuspage.SubscriberTypeSlack:
+			count.Slack++
+		case statuspage.SubscriberTypeTeams:
+			count.Teams++
+		case statuspage.SubscriberTypeIntegrationPartner:
+			count.IntegrationPartner++
+		}
+	}
+	return count
+}
+
+// subscriberHistogram counts subscribed subscribers by type and then state
+func subscriberHistogram(ps *pageState) *statuspage.SubscriberCountByTypeAndState {
+	histogram := &statuspage.SubscriberCountByTypeAndState{}
+	for _, subscriber := range ps.subscribers.list() {
+		if subscriber.UnsubscribedAt != nil {
+			continue
+		}
+
+		var counts *statuspage.SubscriberCountByState
+		switch subscriber.Mode {
+		case statuspage.SubscriberTypeEmail:
+			counts = &histogram.Email
+		case statuspage.SubscriberTypeSMS:
+			counts = &histogram.SMS
+		case statuspage.SubscriberTypeWebhook:
+			counts = &histogram.Webhook
+		case statuspage.SubscriberTypeSlack:
+			counts = &histogram.Slack
+		case statuspage.SubscriberTypeTeams:
+			counts = &histogram.Teams
+		case statuspage.SubscriberTypeIntegrationPartner:
+			counts = &histogram.IntegrationPartner
+		default:
+			continue
+		}
+
+		switch subscriberState(subscriber) {
+		case statuspage.SubscriberStateActive:
+			counts.Active++
+		case statuspage.SubscriberStateUnconfirmed:
+			counts.Unconfirmed++
+		case statuspage.SubscriberStateQuarantined:
+			counts.Quarantined++
+		}
+		counts.Total++
+	}
+	return histogram
+}
+
+// componentsByID returns copies of the components with the given IDs, skipping unknown ones
+func componentsByID(ps *pageState, ids []string) []statuspage.Component {
+	var components []statuspage.Component
+	for _, id := range ids {
+		if component, ok := ps.components.get(id); ok {
+			components = append(components, *component)
+		}
+	}
+	return components
+}
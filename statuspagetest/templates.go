@@ -0,0 +1,64 @@
+package statuspagetest
+
+import (
+	"net/http"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// handleTemplates serves /pages/{page_id}/incident_templates. Like the real API it only lists and creates
+// templates.
+func (s *Server) handleTemplates(w http.ResponseWriter, r *http.Request, ps *pageState, rest []string) {
+	if len(rest) > 0 {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, paginate(r, ps.templates.list()))
+	case http.MethodPost:
+		s.createTemplate(w, r, ps)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createTemplate(w http.ResponseWriter, r *http.Request, ps *pageState) {
+	input, err := readInput(r, "template")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	template := &statuspage.Template{}
+	if err := applyInput(template, without(input, "id", "page_id", "created_at", "updated_at")); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if msgs := validateTemplate(template); len(msgs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, msgs...)
+		return
+	}
+
+	now := s.now()
+	template.ID = s.newID()
+	template.PageID = ps.page.ID
+	template.CreatedAt = now
+	template.UpdatedAt = now
+	ps.templates.add(template.ID, template)
+
+	writeJSON(w, http.StatusCreated, template)
+}
+
+// validateTemplate returns the validation messages for an incident template, if any
+func validateTemplate(template *statuspage.Template) []string {
+	var msgs []string
+	if template.Name == "" {
+		msgs = append(msgs, "Name can't be blank")
+	}
+	if template.Body == "" {
+		msgs = append(msgs, "Body can't be blank")
+	}
+	return msgs
+}
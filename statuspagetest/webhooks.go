@@ -0,0 +1,114 @@
+package statuspagetest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// ComponentUpdateWebhook is a recorded component update notification as POSTed to webhook subscribers
+const ComponentUpdateWebhook = `{
+  "meta": {
+    "unsubscribe": "https://acme.statuspage.io/?unsubscribe=j0vqr9kl3513",
+    "documentation": "https://doers.statuspage.io/customer-notifications/webhooks/"
+  },
+  "page": {
+    "id": "j2mfxwj97wnj",
+    "status_indicator": "major",
+    "status_description": "Partial System Outage"
+  },
+  "component_update": {
+    "created_at": "2013-05-29T21:32:28Z",
+    "new_status": "operational",
+    "old_status": "major_outage",
+    "id": "k7730b5v92bv",
+    "component_id": "rb5wq1dczvbm"
+  },
+  "component": {
+    "created_at": "2013-05-29T21:32:28Z",
+    "id": "rb5wq1dczvbm",
+    "name": "Some Component",
+    "status": "operational"
+  }
+}`
+
+// IncidentUpdateWebhook is a recorded incident update notification as POSTed to webhook subscribers
+const IncidentUpdateWebhook = `{
+  "meta": {
+    "unsubscribe": "https://acme.statuspage.io/?unsubscribe=j0vqr9kl3513",
+    "documentation": "https://doers.statuspage.io/customer-notifications/webhooks/"
+  },
+  "page": {
+    "id": "j2mfxwj97wnj",
+    "status_indicator": "critical",
+    "status_description": "Major System Outage"
+  },
+  "incident": {
+    "backfilled": false,
+    "created_at": "2013-05-29T15:08:51-06:00",
+    "impact": "critical",
+    "impact_override": null,
+    "monitoring_at": "2013-05-29T16:07:53-06:00",
+    "postmortem_body": null,
+    "postmortem_body_last_updated_at": null,
+    "postmortem_ignored": false,
+    "postmortem_notified_subscribers": false,
+    "postmortem_notified_twitter": false,
+    "postmortem_published_at": null,
+    "resolved_at": null,
+    "scheduled_auto_transition": false,
+    "scheduled_for": null,
+    "scheduled_remind_prior": false,
+    "scheduled_reminded_at": null,
+    "scheduled_until": null,
+    "shortlink": "http://j.mp/18zyDQx",
+    "status": "monitoring",
+    "updated_at": "2013-05-29T16:30:35-06:00",
+    "id": "lbkhbwn21v5q",
+    "organization_id": "j2mfxwj97wnj",
+    "incident_updates": [
+      {
+        "body": "A fix has been implemented and we are monitoring the results.",
+        "created_at": "2013-05-29T16:07:53-06:00",
+        "display_at": "2013-05-29T16:07:53-06:00",
+        "id": "drfcwbnpxnr6",
+        "incident_id": "lbkhbwn21v5q",
+        "status": "monitoring",
+        "twitter_updated_at": null,
+        "updated_at": "2013-05-29T16:09:09-06:00",
+        "wants_twitter_update": false
+      },
+      {
+        "body": "We are waiting for the cloud to come back online and will update when we have further information",
+        "created_at": "2013-05-29T15:18:51-06:00",
+        "display_at": "2013-05-29T15:18:51-06:00",
+        "id": "2rryghr4qgrh",
+        "incident_id": "lbkhbwn21v5q",
+        "status": "identified",
+        "twitter_updated_at": null,
+        "updated_at": "2013-05-29T15:28:51-06:00",
+        "wants_twitter_update": false
+      },
+      {
+        "body": "The cloud, located in Norther Virginia, has once again gone the way of the dodo.",
+        "created_at": "2013-05-29T15:08:51-06:00",
+        "display_at": "2013-05-29T15:08:51-06:00",
+        "id": "qbbsfhy5s9kk",
+        "incident_id": "lbkhbwn21v5q",
+        "status": "investigating",
+        "twitter_updated_at": null,
+        "updated_at": "2013-05-29T15:18:51-06:00",
+        "wants_twitter_update": false
+      }
+    ],
+    "name": "Virginia Is Down"
+  }
+}`
+
+// NewWebhookRequest builds the POST request Statuspage sends to a webhook subscriber, for passing a sample
+// payload to a handler through httptest.NewRecorder
+func NewWebhookRequest(payload string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
@@ -2,6 +2,7 @@ package statuspage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -15,82 +16,132 @@ type PageAccessUserRequest struct {
 	PageAccessUser *PageAccessUserInput `json:"page_access_user"`
 }
 
+// PageAccessComponentsRequest lists the components used in a page access membership change
+type PageAccessComponentsRequest struct {
+	ComponentIDs []string `json:"component_ids"`
+}
+
+// PageAccessMetricsRequest lists the metrics used in a page access membership change
+type PageAccessMetricsRequest struct {
+	MetricIDs []string `json:"metric_ids"`
+}
+
 type PageAccessUserInput struct {
-	Email              string   `json:"email,omitempty"`
-	ExternalLogin      string   `json:"external_login,omitempty"`
-	PageAccessGroupIDs []string `json:"page_access_group_ids,omitempty"`
-	ComponentIDs       []string `json:"component_ids,omitempty"`
-	MetricIDs          []string `json:"metric_ids,omitempty"`
+	Email              string           `json:"email,omitempty" replace:"omitempty"`
+	ExternalLogin      Nullable[string] `json:"external_login,omitempty"`
+	PageAccessGroupIDs []string         `json:"page_access_group_ids,omitempty"`
+	ComponentIDs       []string         `json:"component_ids,omitempty"`
+	MetricIDs          []string         `json:"metric_ids,omitempty"`
 }
 
-// List gets a list of page access users for audience-specific status pages
-func (s *PageAccessUsersService) List(ctx context.Context, pageID string) ([]*PageAccessUser, error) {
+// List retrieves a single page of page access users for a specific status page
+func (s *PageAccessUsersService) List(ctx context.Context, pageID string, opts *ListOptions) ([]*PageAccessUser, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_users", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var users []*PageAccessUser
-	_, err = s.client.Do(ctx, req, &users)
+	resp, err := s.client.Do(ctx, req, &users)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return users, nil
+	return users, resp, nil
+}
+
+// ListAll retrieves every page access user by walking through all result pages
+func (s *PageAccessUsersService) ListAll(ctx context.Context, pageID string, opts *ListOptions) ([]*PageAccessUser, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*PageAccessUser, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
+}
+
+// Walk calls fn for every page access user, requesting further pages until an empty page is returned
+func (s *PageAccessUsersService) Walk(ctx context.Context, pageID string, opts *ListOptions, fn func(*PageAccessUser) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*PageAccessUser, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
 }
 
 // Get retrieves a specific page access user by ID
-func (s *PageAccessUsersService) Get(ctx context.Context, pageID, userID string) (*PageAccessUser, error) {
+func (s *PageAccessUsersService) Get(ctx context.Context, pageID, userID string) (*PageAccessUser, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_users/%s", pageID, userID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	user := new(PageAccessUser)
-	_, err = s.client.Do(ctx, req, user)
+	resp, err := s.client.Do(ctx, req, user)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return user, nil
+	return user, resp, nil
 }
 
 // Create creates a new page access user for audience-specific access control
-func (s *PageAccessUsersService) Create(ctx context.Context, pageID string, user *PageAccessUserInput) (*PageAccessUser, error) {
+func (s *PageAccessUsersService) Create(ctx context.Context, pageID string, user *PageAccessUserInput) (*PageAccessUser, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_users", pageID)
 	userReq := &PageAccessUserRequest{PageAccessUser: user}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, userReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newUser := new(PageAccessUser)
-	_, err = s.client.Do(ctx, req, newUser)
+	resp, err := s.client.Do(ctx, req, newUser)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newUser, nil
+	return newUser, resp, nil
 }
 
 // Update modifies an existing page access user
-func (s *PageAccessUsersService) Update(ctx context.Context, pageID, userID string, user *PageAccessUserInput) (*PageAccessUser, error) {
+func (s *PageAccessUsersService) Update(ctx context.Context, pageID, userID string, user *PageAccessUserInput) (*PageAccessUser, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_users/%s", pageID, userID)
 	userReq := &PageAccessUserRequest{PageAccessUser: user}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, userReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedUser := new(PageAccessUser)
-	_, err = s.client.Do(ctx, req, updatedUser)
+	resp, err := s.client.Do(ctx, req, updatedUser)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updatedUser, nil
+	return updatedUser, resp, nil
+}
+
+// Replace overwrites every editable field of a page access user, clearing those left unset in the input
+func (s *PageAccessUsersService) Replace(ctx context.Context, pageID, userID string, user *PageAccessUserInput) (*PageAccessUser, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s", pageID, userID)
+	userReq, err := replaceRequest("page_access_user", user)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, userReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedUser := new(PageAccessUser)
+	resp, err := s.client.Do(ctx, req, updatedUser)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedUser, resp, nil
 }
 
 // Delete removes a page access user
@@ -109,6 +160,170 @@ func (s *PageAccessUsersService) Delete(ctx context.Context, pageID, userID stri
 	return resp, nil
 }
 
+// ListComponents retrieves a single page of components visible to a page access user
+func (s *PageAccessUsersService) ListComponents(ctx context.Context, pageID, userID string, opts *ListOptions) ([]*Component, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/components", pageID, userID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []*Component
+	resp, err := s.client.Do(ctx, req, &components)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return components, resp, nil
+}
+
+// ListAllComponents retrieves every component visible to a page access user by walking through all result pages
+func (s *PageAccessUsersService) ListAllComponents(ctx context.Context, pageID, userID string, opts *ListOptions) ([]*Component, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Component, *Response, error) {
+		return s.ListComponents(ctx, pageID, userID, opts.forPage(page))
+	})
+}
+
+// AddComponents grants a page access user access to additional components
+func (s *PageAccessUsersService) AddComponents(ctx context.Context, pageID, userID string, componentIDs []string) (*PageAccessUser, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/components", pageID, userID)
+	return s.updateComponents(ctx, http.MethodPatch, u, &PageAccessComponentsRequest{ComponentIDs: componentIDs})
+}
+
+// ReplaceComponents sets the components a page access user can access, removing access to any not listed
+func (s *PageAccessUsersService) ReplaceComponents(ctx context.Context, pageID, userID string, componentIDs []string) (*PageAccessUser, *Response, error) {
+	if componentIDs == nil {
+		componentIDs = []string{}
+	}
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/components", pageID, userID)
+	return s.updateComponents(ctx, http.MethodPost, u, &PageAccessComponentsRequest{ComponentIDs: componentIDs})
+}
+
+// RemoveComponents revokes a page access user's access to the listed components. It returns an error when none are
+// listed; use RemoveAllComponents to revoke access to every component.
+func (s *PageAccessUsersService) RemoveComponents(ctx context.Context, pageID, userID string, componentIDs []string) (*PageAccessUser, *Response, error) {
+	if len(componentIDs) == 0 {
+		return nil, nil, errors.New("statuspage: RemoveComponents requires component IDs; use RemoveAllComponents to revoke access to every component")
+	}
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/components", pageID, userID)
+	return s.updateComponents(ctx, http.MethodDelete, u, &PageAccessComponentsRequest{ComponentIDs: componentIDs})
+}
+
+// RemoveAllComponents revokes a page access user's access to every component
+func (s *PageAccessUsersService) RemoveAllComponents(ctx context.Context, pageID, userID string) (*PageAccessUser, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/components", pageID, userID)
+	return s.updateComponents(ctx, http.MethodDelete, u, nil)
+}
+
+// RemoveComponent revokes a page access user's access to a single component
+func (s *PageAccessUsersService) RemoveComponent(ctx context.Context, pageID, userID, componentID string) (*PageAccessUser, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/components/%s", pageID, userID, componentID)
+	return s.updateComponents(ctx, http.MethodDelete, u, nil)
+}
+
+// updateComponents sends a component membership change and decodes the updated page access user
+func (s *PageAccessUsersService) updateComponents(ctx context.Context, method, u string, body interface{}) (*PageAccessUser, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(PageAccessUser)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+// ListMetrics retrieves a single page of metrics visible to a page access user
+func (s *PageAccessUsersService) ListMetrics(ctx context.Context, pageID, userID string, opts *ListOptions) ([]*Metric, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/metrics", pageID, userID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metrics []*Metric
+	resp, err := s.client.Do(ctx, req, &metrics)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return metrics, resp, nil
+}
+
+// ListAllMetrics retrieves every metric visible to a page access user by walking through all result pages
+func (s *PageAccessUsersService) ListAllMetrics(ctx context.Context, pageID, userID string, opts *ListOptions) ([]*Metric, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Metric, *Response, error) {
+		return s.ListMetrics(ctx, pageID, userID, opts.forPage(page))
+	})
+}
+
+// AddMetrics grants a page access user access to additional metrics
+func (s *PageAccessUsersService) AddMetrics(ctx context.Context, pageID, userID string, metricIDs []string) (*PageAccessUser, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/metrics", pageID, userID)
+	return s.updateMetrics(ctx, http.MethodPatch, u, &PageAccessMetricsRequest{MetricIDs: metricIDs})
+}
+
+// ReplaceMetrics sets the metrics a page access user can access, removing access to any not listed
+func (s *PageAccessUsersService) ReplaceMetrics(ctx context.Context, pageID, userID string, metricIDs []string) (*PageAccessUser, *Response, error) {
+	if metricIDs == nil {
+		metricIDs = []string{}
+	}
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/metrics", pageID, userID)
+	return s.updateMetrics(ctx, http.MethodPost, u, &PageAccessMetricsRequest{MetricIDs: metricIDs})
+}
+
+// RemoveMetrics revokes a page access user's access to the listed metrics. It returns an error when none are
+// listed; use RemoveAllMetrics to revoke access to every metric.
+func (s *PageAccessUsersService) RemoveMetrics(ctx context.Context, pageID, userID string, metricIDs []string) (*PageAccessUser, *Response, error) {
+	if len(metricIDs) == 0 {
+		return nil, nil, errors.New("statuspage: RemoveMetrics requires metric IDs; use RemoveAllMetrics to revoke access to every metric")
+	}
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/metrics", pageID, userID)
+	return s.updateMetrics(ctx, http.MethodDelete, u, &PageAccessMetricsRequest{MetricIDs: metricIDs})
+}
+
+// RemoveAllMetrics revokes a page access user's access to every metric
+func (s *PageAccessUsersService) RemoveAllMetrics(ctx context.Context, pageID, userID string) (*PageAccessUser, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/metrics", pageID, userID)
+	return s.updateMetrics(ctx, http.MethodDelete, u, nil)
+}
+
+// RemoveMetric revokes a page access user's access to a single metric
+func (s *PageAccessUsersService) RemoveMetric(ctx context.Context, pageID, userID, metricID string) (*PageAccessUser, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_users/%s/metrics/%s", pageID, userID, metricID)
+	return s.updateMetrics(ctx, http.MethodDelete, u, nil)
+}
+
+// updateMetrics sends a metric membership change and decodes the updated page access user
+func (s *PageAccessUsersService) updateMetrics(ctx context.Context, method, u string, body interface{}) (*PageAccessUser, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(PageAccessUser)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
 // PageAccessGroupsService handles communication with page access groups for audience-specific status pages
 type PageAccessGroupsService struct {
 	client *Client
@@ -119,83 +334,123 @@ type PageAccessGroupRequest struct {
 }
 
 type PageAccessGroupInput struct {
-	Name               string   `json:"name,omitempty"`
-	Description        string   `json:"description,omitempty"`
-	Color              string   `json:"color,omitempty"`
-	ComponentIDs       []string `json:"component_ids,omitempty"`
-	MetricIDs          []string `json:"metric_ids,omitempty"`
-	PageAccessUserIDs  []string `json:"page_access_user_ids,omitempty"`
-	ExternalIdentifier string   `json:"external_identifier,omitempty"`
+	Name               string           `json:"name,omitempty" replace:"omitempty"`
+	Description        Nullable[string] `json:"description,omitempty"`
+	Color              Nullable[string] `json:"color,omitempty"`
+	ComponentIDs       []string         `json:"component_ids,omitempty"`
+	MetricIDs          []string         `json:"metric_ids,omitempty"`
+	PageAccessUserIDs  []string         `json:"page_access_user_ids,omitempty"`
+	ExternalIdentifier Nullable[string] `json:"external_identifier,omitempty"`
 }
 
-// List gets a list of page access groups for audience-specific status pages
-func (s *PageAccessGroupsService) List(ctx context.Context, pageID string) ([]*PageAccessGroup, error) {
+// List retrieves a single page of page access groups for a specific status page
+func (s *PageAccessGroupsService) List(ctx context.Context, pageID string, opts *ListOptions) ([]*PageAccessGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_groups", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var groups []*PageAccessGroup
-	_, err = s.client.Do(ctx, req, &groups)
+	resp, err := s.client.Do(ctx, req, &groups)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return groups, nil
+	return groups, resp, nil
+}
+
+// ListAll retrieves every page access group by walking through all result pages
+func (s *PageAccessGroupsService) ListAll(ctx context.Context, pageID string, opts *ListOptions) ([]*PageAccessGroup, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*PageAccessGroup, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
+}
+
+// Walk calls fn for every page access group, requesting further pages until an empty page is returned
+func (s *PageAccessGroupsService) Walk(ctx context.Context, pageID string, opts *ListOptions, fn func(*PageAccessGroup) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*PageAccessGroup, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
 }
 
 // Get retrieves a specific page access group by ID
-func (s *PageAccessGroupsService) Get(ctx context.Context, pageID, groupID string) (*PageAccessGroup, error) {
+func (s *PageAccessGroupsService) Get(ctx context.Context, pageID, groupID string) (*PageAccessGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_groups/%s", pageID, groupID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	group := new(PageAccessGroup)
-	_, err = s.client.Do(ctx, req, group)
+	resp, err := s.client.Do(ctx, req, group)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return group, nil
+	return group, resp, nil
 }
 
 // Create creates a new page access group for organizing users with similar access needs
-func (s *PageAccessGroupsService) Create(ctx context.Context, pageID string, group *PageAccessGroupInput) (*PageAccessGroup, error) {
+func (s *PageAccessGroupsService) Create(ctx context.Context, pageID string, group *PageAccessGroupInput) (*PageAccessGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_groups", pageID)
 	groupReq := &PageAccessGroupRequest{PageAccessGroup: group}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, groupReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newGroup := new(PageAccessGroup)
-	_, err = s.client.Do(ctx, req, newGroup)
+	resp, err := s.client.Do(ctx, req, newGroup)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newGroup, nil
+	return newGroup, resp, nil
 }
 
 // Update modifies an existing page access group
-func (s *PageAccessGroupsService) Update(ctx context.Context, pageID, groupID string, group *PageAccessGroupInput) (*PageAccessGroup, error) {
+func (s *PageAccessGroupsService) Update(ctx context.Context, pageID, groupID string, group *PageAccessGroupInput) (*PageAccessGroup, *Response, error) {
 	u := fmt.Sprintf("pages/%s/page_access_groups/%s", pageID, groupID)
 	groupReq := &PageAccessGroupRequest{PageAccessGroup: group}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, groupReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedGroup := new(PageAccessGroup)
-	_, err = s.client.Do(ctx, req, updatedGroup)
+	resp, err := s.client.Do(ctx, req, updatedGroup)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updatedGroup, nil
+	return updatedGroup, resp, nil
+}
+
+// Replace overwrites every editable field of a page access group, clearing those left unset in the input
+func (s *PageAccessGroupsService) Replace(ctx context.Context, pageID, groupID string, group *PageAccessGroupInput) (*PageAccessGroup, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_groups/%s", pageID, groupID)
+	groupReq, err := replaceRequest("page_access_group", group)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, groupReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedGroup := new(PageAccessGroup)
+	resp, err := s.client.Do(ctx, req, updatedGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedGroup, resp, nil
 }
 
 // Delete removes a page access group
@@ -214,6 +469,88 @@ func (s *PageAccessGroupsService) Delete(ctx context.Context, pageID, groupID st
 	return resp, nil
 }
 
+// ListComponents retrieves a single page of components visible to a page access group
+func (s *PageAccessGroupsService) ListComponents(ctx context.Context, pageID, groupID string, opts *ListOptions) ([]*Component, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_groups/%s/components", pageID, groupID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []*Component
+	resp, err := s.client.Do(ctx, req, &components)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return components, resp, nil
+}
+
+// ListAllComponents retrieves every component visible to a page access group by walking through all result pages
+func (s *PageAccessGroupsService) ListAllComponents(ctx context.Context, pageID, groupID string, opts *ListOptions) ([]*Component, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Component, *Response, error) {
+		return s.ListComponents(ctx, pageID, groupID, opts.forPage(page))
+	})
+}
+
+// AddComponents grants a page access group access to additional components
+func (s *PageAccessGroupsService) AddComponents(ctx context.Context, pageID, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_groups/%s/components", pageID, groupID)
+	return s.updateComponents(ctx, http.MethodPatch, u, &PageAccessComponentsRequest{ComponentIDs: componentIDs})
+}
+
+// ReplaceComponents sets the components a page access group can access, removing access to any not listed
+func (s *PageAccessGroupsService) ReplaceComponents(ctx context.Context, pageID, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error) {
+	if componentIDs == nil {
+		componentIDs = []string{}
+	}
+	u := fmt.Sprintf("pages/%s/page_access_groups/%s/components", pageID, groupID)
+	return s.updateComponents(ctx, http.MethodPost, u, &PageAccessComponentsRequest{ComponentIDs: componentIDs})
+}
+
+// RemoveComponents revokes a page access group's access to the listed components. It returns an error when none are
+// listed; use RemoveAllComponents to revoke access to every component.
+func (s *PageAccessGroupsService) RemoveComponents(ctx context.Context, pageID, groupID string, componentIDs []string) (*PageAccessGroup, *Response, error) {
+	if len(componentIDs) == 0 {
+		return nil, nil, errors.New("statuspage: RemoveComponents requires component IDs; use RemoveAllComponents to revoke access to every component")
+	}
+	u := fmt.Sprintf("pages/%s/page_access_groups/%s/components", pageID, groupID)
+	return s.updateComponents(ctx, http.MethodDelete, u, &PageAccessComponentsRequest{ComponentIDs: componentIDs})
+}
+
+// RemoveAllComponents revokes a page access group's access to every component
+func (s *PageAccessGroupsService) RemoveAllComponents(ctx context.Context, pageID, groupID string) (*PageAccessGroup, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_groups/%s/components", pageID, groupID)
+	return s.updateComponents(ctx, http.MethodDelete, u, nil)
+}
+
+// RemoveComponent revokes a page access group's access to a single component
+func (s *PageAccessGroupsService) RemoveComponent(ctx context.Context, pageID, groupID, componentID string) (*PageAccessGroup, *Response, error) {
+	u := fmt.Sprintf("pages/%s/page_access_groups/%s/components/%s", pageID, groupID, componentID)
+	return s.updateComponents(ctx, http.MethodDelete, u, nil)
+}
+
+// updateComponents sends a component membership change and decodes the updated page access group
+func (s *PageAccessGroupsService) updateComponents(ctx context.Context, method, u string, body interface{}) (*PageAccessGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(PageAccessGroup)
+	resp, err := s.client.Do(ctx, req, group)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
 // TemplatesService handles communication with incident templates for faster incident creation
 type TemplatesService struct {
 	client *Client
@@ -224,82 +561,101 @@ type TemplateRequest struct {
 }
 
 type TemplateInput struct {
-	Name                    string `json:"name,omitempty"`
-	Body                    string `json:"body,omitempty"`
-	GroupID                 string `json:"group_id,omitempty"`
-	UpdateStatus            string `json:"update_status,omitempty"`
-	ShouldTweet             *bool  `json:"should_tweet,omitempty"`
-	ShouldSendNotifications *bool  `json:"should_send_notifications,omitempty"`
+	Name                    string           `json:"name,omitempty"`
+	Body                    string           `json:"body,omitempty"`
+	GroupID                 Nullable[string] `json:"group_id,omitempty"`
+	UpdateStatus            Nullable[string] `json:"update_status,omitempty"`
+	ShouldTweet             Nullable[bool]   `json:"should_tweet,omitempty"`
+	ShouldSendNotifications Nullable[bool]   `json:"should_send_notifications,omitempty"`
 }
 
-// List gets a list of incident templates for creating incidents with pre-filled information
-func (s *TemplatesService) List(ctx context.Context, pageID string) ([]*Template, error) {
+// List retrieves a single page of incident templates for a specific status page
+func (s *TemplatesService) List(ctx context.Context, pageID string, opts *ListOptions) ([]*Template, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incident_templates", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var templates []*Template
-	_, err = s.client.Do(ctx, req, &templates)
+	resp, err := s.client.Do(ctx, req, &templates)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return templates, nil
+	return templates, resp, nil
+}
+
+// ListAll retrieves every incident template by walking through all result pages
+func (s *TemplatesService) ListAll(ctx context.Context, pageID string, opts *ListOptions) ([]*Template, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Template, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
+}
+
+// Walk calls fn for every incident template, requesting further pages until an empty page is returned
+func (s *TemplatesService) Walk(ctx context.Context, pageID string, opts *ListOptions, fn func(*Template) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*Template, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
 }
 
 // Get retrieves a specific incident template by ID
-func (s *TemplatesService) Get(ctx context.Context, pageID, templateID string) (*Template, error) {
+func (s *TemplatesService) Get(ctx context.Context, pageID, templateID string) (*Template, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incident_templates/%s", pageID, templateID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	template := new(Template)
-	_, err = s.client.Do(ctx, req, template)
+	resp, err := s.client.Do(ctx, req, template)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return template, nil
+	return template, resp, nil
 }
 
 // Create creates a new incident template with pre-filled name and message for faster incident creation
-func (s *TemplatesService) Create(ctx context.Context, pageID string, template *TemplateInput) (*Template, error) {
+func (s *TemplatesService) Create(ctx context.Context, pageID string, template *TemplateInput) (*Template, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incident_templates", pageID)
 	templateReq := &TemplateRequest{Template: template}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, templateReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newTemplate := new(Template)
-	_, err = s.client.Do(ctx, req, newTemplate)
+	resp, err := s.client.Do(ctx, req, newTemplate)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newTemplate, nil
+	return newTemplate, resp, nil
 }
 
 // Update modifies an existing incident template
-func (s *TemplatesService) Update(ctx context.Context, pageID, templateID string, template *TemplateInput) (*Template, error) {
+func (s *TemplatesService) Update(ctx context.Context, pageID, templateID string, template *TemplateInput) (*Template, *Response, error) {
 	u := fmt.Sprintf("pages/%s/incident_templates/%s", pageID, templateID)
 	templateReq := &TemplateRequest{Template: template}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, templateReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedTemplate := new(Template)
-	_, err = s.client.Do(ctx, req, updatedTemplate)
+	resp, err := s.client.Do(ctx, req, updatedTemplate)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updatedTemplate, nil
+	return updatedTemplate, resp, nil
 }
 
 // Delete removes an incident template
@@ -328,44 +684,65 @@ type StatusEmbedConfigRequest struct {
 }
 
 type StatusEmbedConfigInput struct {
-	Position                   string `json:"position,omitempty"`
-	IncidentBackgroundColor    string `json:"incident_background_color,omitempty"`
-	IncidentTextColor          string `json:"incident_text_color,omitempty"`
-	MaintenanceBackgroundColor string `json:"maintenance_background_color,omitempty"`
-	MaintenanceTextColor       string `json:"maintenance_text_color,omitempty"`
+	Position                   string           `json:"position,omitempty" replace:"omitempty"`
+	IncidentBackgroundColor    Nullable[string] `json:"incident_background_color,omitempty"`
+	IncidentTextColor          Nullable[string] `json:"incident_text_color,omitempty"`
+	MaintenanceBackgroundColor Nullable[string] `json:"maintenance_background_color,omitempty"`
+	MaintenanceTextColor       Nullable[string] `json:"maintenance_text_color,omitempty"`
 }
 
 // Get retrieves status embed config settings for customizing the appearance of embedded status widgets
-func (s *StatusEmbedConfigService) Get(ctx context.Context, pageID string) (*StatusEmbedConfig, error) {
+func (s *StatusEmbedConfigService) Get(ctx context.Context, pageID string) (*StatusEmbedConfig, *Response, error) {
 	u := fmt.Sprintf("pages/%s/status_embed_config", pageID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	config := new(StatusEmbedConfig)
-	_, err = s.client.Do(ctx, req, config)
+	resp, err := s.client.Do(ctx, req, config)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return config, nil
+	return config, resp, nil
 }
 
 // Update modifies the status embed config settings for customizing widget appearance
-func (s *StatusEmbedConfigService) Update(ctx context.Context, pageID string, config *StatusEmbedConfigInput) (*StatusEmbedConfig, error) {
+func (s *StatusEmbedConfigService) Update(ctx context.Context, pageID string, config *StatusEmbedConfigInput) (*StatusEmbedConfig, *Response, error) {
 	u := fmt.Sprintf("pages/%s/status_embed_config", pageID)
 	configReq := &StatusEmbedConfigRequest{StatusEmbedConfig: config}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, configReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedConfig := new(StatusEmbedConfig)
-	_, err = s.client.Do(ctx, req, updatedConfig)
+	resp, err := s.client.Do(ctx, req, updatedConfig)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
+	}
+
+	return updatedConfig, resp, nil
+}
+
+// Replace overwrites every status embed config setting, clearing those left unset in the input
+func (s *StatusEmbedConfigService) Replace(ctx context.Context, pageID string, config *StatusEmbedConfigInput) (*StatusEmbedConfig, *Response, error) {
+	u := fmt.Sprintf("pages/%s/status_embed_config", pageID)
+	configReq, err := replaceRequest("status_embed_config", config)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, configReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedConfig := new(StatusEmbedConfig)
+	resp, err := s.client.Do(ctx, req, updatedConfig)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return updatedConfig, nil
+	return updatedConfig, resp, nil
 }
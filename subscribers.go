@@ -2,6 +2,8 @@ package statuspage
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -15,91 +17,278 @@ type SubscriberRequest struct {
 }
 
 type SubscriberInput struct {
-	Email                        string   `json:"email,omitempty"`
-	Endpoint                     string   `json:"endpoint,omitempty"`
-	PhoneCountry                 string   `json:"phone_country,omitempty"`
-	PhoneNumber                  string   `json:"phone_number,omitempty"`
-	SkipConfirmationNotification *bool    `json:"skip_confirmation_notification,omitempty"`
-	ComponentIDs                 []string `json:"component_ids,omitempty"`
-	PageAccessUserID             string   `json:"page_access_user_id,omitempty"`
+	Email                        string           `json:"email,omitempty"`
+	Endpoint                     Nullable[string] `json:"endpoint,omitempty"`
+	PhoneCountry                 Nullable[string] `json:"phone_country,omitempty"`
+	PhoneNumber                  Nullable[string] `json:"phone_number,omitempty"`
+	SkipConfirmationNotification Nullable[bool]   `json:"skip_confirmation_notification,omitempty"`
+	ComponentIDs                 []string         `json:"component_ids,omitempty"`
+	PageAccessUserID             Nullable[string] `json:"page_access_user_id,omitempty"`
 }
 
+const (
+	SubscriberTypeEmail              = "email"
+	SubscriberTypeSMS                = "sms"
+	SubscriberTypeSlack              = "slack"
+	SubscriberTypeWebhook            = "webhook"
+	SubscriberTypeTeams              = "teams"
+	SubscriberTypeIntegrationPartner = "integration_partner"
+
+	SubscriberStateActive      = "active"
+	SubscriberStateUnconfirmed = "unconfirmed"
+	SubscriberStateQuarantined = "quarantined"
+	SubscriberStateAll         = "all"
+)
+
+// SubscriberBulkInput selects the subscribers affected by Unsubscribe and Reactivate. Either list
+// SubscriberIDs or set All; Type and State narrow the selection further where the endpoint supports it.
+type SubscriberBulkInput struct {
+	SubscriberIDs                  []string       `json:"-"`
+	All                            bool           `json:"-"`
+	Type                           string         `json:"type,omitempty"`
+	State                          string         `json:"state,omitempty"`
+	SkipUnsubscriptionNotification Nullable[bool] `json:"skip_unsubscription_notification,omitempty"`
+}
+
+func (in SubscriberBulkInput) MarshalJSON() ([]byte, error) {
+	type bulkInput SubscriberBulkInput
+	body := struct {
+		Subscribers interface{} `json:"subscribers"`
+		bulkInput
+	}{Subscribers: selectedSubscribers(in.All, in.SubscriberIDs), bulkInput: bulkInput(in)}
+	return json.Marshal(body)
+}
+
+func (in *SubscriberBulkInput) selects() bool {
+	return in != nil && (in.All || len(in.SubscriberIDs) > 0)
+}
+
+// SubscriberResendInput selects the subscribers ResendConfirmations sends a new confirmation to. Either list
+// SubscriberIDs or set All; only unconfirmed email subscribers receive one.
+type SubscriberResendInput struct {
+	SubscriberIDs []string
+	All           bool
+}
+
+func (in SubscriberResendInput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Subscribers interface{} `json:"subscribers"`
+	}{selectedSubscribers(in.All, in.SubscriberIDs)})
+}
+
+func (in *SubscriberResendInput) selects() bool {
+	return in != nil && (in.All || len(in.SubscriberIDs) > 0)
+}
+
+// selectedSubscribers returns the value of the subscribers parameter of the bulk endpoints, either "all" or
+// a list of subscriber IDs
+func selectedSubscribers(all bool, ids []string) interface{} {
+	if all {
+		return "all"
+	}
+	return ids
+}
+
+// subscriberSelection is the body of a bulk subscriber request, which must select at least one subscriber
+type subscriberSelection interface {
+	selects() bool
+}
+
+const (
+	SubscriberSortPrimary       = "primary"
+	SubscriberSortCreatedAt     = "created_at"
+	SubscriberSortQuarantinedAt = "quarantined_at"
+	SubscriberSortRelevance     = "relevance"
+
+	SortAscending  = "asc"
+	SortDescending = "desc"
+)
+
+// SubscriberListOptions filters and sorts the page subscriber list. Page is zero-based and Limit sets the
+// page size, matching the API.
 type SubscriberListOptions struct {
-	Q       string `url:"q,omitempty"`
-	Sort    string `url:"sort,omitempty"`
-	Page    int    `url:"page,omitempty"`
-	PerPage int    `url:"per_page,omitempty"`
+	Q             string `url:"q,omitempty"`
+	Type          string `url:"type,omitempty"`
+	State         string `url:"state,omitempty"`
+	Limit         int    `url:"limit,omitempty"`
+	Page          int    `url:"page,omitempty"` // zero-based: the first page of subscribers is page 0
+	SortField     string `url:"sort_field,omitempty"`
+	SortDirection string `url:"sort_direction,omitempty"`
+
+	// Deprecated: the API ignores sort; use SortField and SortDirection.
+	Sort string `url:"sort,omitempty"`
+	// Deprecated: the API ignores per_page; use Limit.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+type SubscriberCountOptions struct {
+	Type  string `url:"type,omitempty"`
+	State string `url:"state,omitempty"`
 }
 
-func (s *SubscribersService) List(ctx context.Context, pageID string, opts *SubscriberListOptions) ([]*Subscriber, error) {
+// startPage converts the zero-based API page into the one-based page used by walkPages
+func (o *SubscriberListOptions) startPage() int {
+	if o == nil {
+		return 1
+	}
+	return o.Page + 1
+}
+
+// forPage copies the options for the given one-based walkPages page
+func (o *SubscriberListOptions) forPage(page int) *SubscriberListOptions {
+	opts := SubscriberListOptions{}
+	if o != nil {
+		opts = *o
+	}
+	opts.Page = page - 1
+	return &opts
+}
+
+func (s *SubscribersService) List(ctx context.Context, pageID string, opts *SubscriberListOptions) ([]*Subscriber, *Response, error) {
 	u := fmt.Sprintf("pages/%s/subscribers", pageID)
 	u, err := addOptions(u, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var subscribers []*Subscriber
-	_, err = s.client.Do(ctx, req, &subscribers)
+	resp, err := s.client.Do(ctx, req, &subscribers)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return subscribers, nil
+	return subscribers, resp, nil
+}
+
+func (s *SubscribersService) ListAll(ctx context.Context, pageID string, opts *SubscriberListOptions) ([]*Subscriber, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Subscriber, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	})
+}
+
+func (s *SubscribersService) Walk(ctx context.Context, pageID string, opts *SubscriberListOptions, fn func(*Subscriber) error) (*Response, error) {
+	return walkPages(ctx, opts.startPage(), func(page int) ([]*Subscriber, *Response, error) {
+		return s.List(ctx, pageID, opts.forPage(page))
+	}, fn)
 }
 
-func (s *SubscribersService) Get(ctx context.Context, pageID, subscriberID string) (*Subscriber, error) {
+func (s *SubscribersService) ListUnsubscribed(ctx context.Context, pageID string, opts *ListOptions) ([]*Subscriber, *Response, error) {
+	u := fmt.Sprintf("pages/%s/subscribers/unsubscribed", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscribers []*Subscriber
+	resp, err := s.client.Do(ctx, req, &subscribers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscribers, resp, nil
+}
+
+func (s *SubscribersService) ListAllUnsubscribed(ctx context.Context, pageID string, opts *ListOptions) ([]*Subscriber, *Response, error) {
+	return collectPages(ctx, opts.startPage(), func(page int) ([]*Subscriber, *Response, error) {
+		return s.ListUnsubscribed(ctx, pageID, opts.forPage(page))
+	})
+}
+
+func (s *SubscribersService) Count(ctx context.Context, pageID string, opts *SubscriberCountOptions) (*SubscriberCountByType, *Response, error) {
+	u := fmt.Sprintf("pages/%s/subscribers/count", pageID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(SubscriberCountByType)
+	resp, err := s.client.Do(ctx, req, count)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return count, resp, nil
+}
+
+func (s *SubscribersService) HistogramByState(ctx context.Context, pageID string) (*SubscriberCountByTypeAndState, *Response, error) {
+	u := fmt.Sprintf("pages/%s/subscribers/histogram_by_state", pageID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	histogram := new(SubscriberCountByTypeAndState)
+	resp, err := s.client.Do(ctx, req, histogram)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return histogram, resp, nil
+}
+
+func (s *SubscribersService) Get(ctx context.Context, pageID, subscriberID string) (*Subscriber, *Response, error) {
 	u := fmt.Sprintf("pages/%s/subscribers/%s", pageID, subscriberID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	subscriber := new(Subscriber)
-	_, err = s.client.Do(ctx, req, subscriber)
+	resp, err := s.client.Do(ctx, req, subscriber)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return subscriber, nil
+	return subscriber, resp, nil
 }
 
-func (s *SubscribersService) Create(ctx context.Context, pageID string, subscriber *SubscriberInput) (*Subscriber, error) {
+func (s *SubscribersService) Create(ctx context.Context, pageID string, subscriber *SubscriberInput) (*Subscriber, *Response, error) {
 	u := fmt.Sprintf("pages/%s/subscribers", pageID)
 	subscriberReq := &SubscriberRequest{Subscriber: subscriber}
 	req, err := s.client.NewRequest(ctx, http.MethodPost, u, subscriberReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	newSubscriber := new(Subscriber)
-	_, err = s.client.Do(ctx, req, newSubscriber)
+	resp, err := s.client.Do(ctx, req, newSubscriber)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return newSubscriber, nil
+	return newSubscriber, resp, nil
 }
 
-func (s *SubscribersService) Update(ctx context.Context, pageID, subscriberID string, subscriber *SubscriberInput) (*Subscriber, error) {
+func (s *SubscribersService) Update(ctx context.Context, pageID, subscriberID string, subscriber *SubscriberInput) (*Subscriber, *Response, error) {
 	u := fmt.Sprintf("pages/%s/subscribers/%s", pageID, subscriberID)
 	subscriberReq := &SubscriberRequest{Subscriber: subscriber}
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, u, subscriberReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	updatedSubscriber := new(Subscriber)
-	_, err = s.client.Do(ctx, req, updatedSubscriber)
+	resp, err := s.client.Do(ctx, req, updatedSubscriber)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	return updatedSubscriber, nil
+	return updatedSubscriber, resp, nil
 }
 
 func (s *SubscribersService) Delete(ctx context.Context, pageID, subscriberID string) (*Response, error) {
@@ -117,36 +306,35 @@ func (s *SubscribersService) Delete(ctx context.Context, pageID, subscriberID st
 	return resp, nil
 }
 
-func (s *SubscribersService) Reactivate(ctx context.Context, pageID, subscriberID string) (*Subscriber, error) {
-	u := fmt.Sprintf("pages/%s/subscribers/%s/reactivate", pageID, subscriberID)
-	req, err := s.client.NewRequest(ctx, http.MethodPost, u, nil)
-	if err != nil {
-		return nil, err
-	}
+func (s *SubscribersService) Unsubscribe(ctx context.Context, pageID string, bulk *SubscriberBulkInput) (*Response, error) {
+	return s.bulk(ctx, pageID, "unsubscribe", bulk)
+}
 
-	subscriber := new(Subscriber)
-	_, err = s.client.Do(ctx, req, subscriber)
-	if err != nil {
-		return nil, err
-	}
+func (s *SubscribersService) Reactivate(ctx context.Context, pageID string, bulk *SubscriberBulkInput) (*Response, error) {
+	return s.bulk(ctx, pageID, "reactivate", bulk)
+}
 
-	return subscriber, nil
+func (s *SubscribersService) ResendConfirmations(ctx context.Context, pageID string, resend *SubscriberResendInput) (*Response, error) {
+	return s.bulk(ctx, pageID, "resend_confirmation", resend)
 }
 
-func (s *SubscribersService) Unsubscribe(ctx context.Context, pageID, subscriberID string) (*Subscriber, error) {
-	u := fmt.Sprintf("pages/%s/subscribers/%s/unsubscribe", pageID, subscriberID)
-	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+func (s *SubscribersService) bulk(ctx context.Context, pageID, action string, bulk subscriberSelection) (*Response, error) {
+	if !bulk.selects() {
+		return nil, errors.New("statuspage: bulk subscriber operation requires subscriber IDs or All")
+	}
+
+	u := fmt.Sprintf("pages/%s/subscribers/%s", pageID, action)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, bulk)
 	if err != nil {
 		return nil, err
 	}
 
-	subscriber := new(Subscriber)
-	_, err = s.client.Do(ctx, req, subscriber)
+	resp, err := s.client.Do(ctx, req, nil)
 	if err != nil {
-		return nil, err
+		return resp, err
 	}
 
-	return subscriber, nil
+	return resp, nil
 }
 
 func (s *SubscribersService) ResendConfirmation(ctx context.Context, pageID, subscriberID string) (*Response, error) {
@@ -0,0 +1,105 @@
+package statuspage_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+)
+
+// createSubscribers creates n email subscribers and returns their IDs
+func createSubscribers(t *testing.T, client *statuspage.Client, pageID, prefix string, n int) []string {
+	t.Helper()
+	var ids []string
+	for i := 0; i < n; i++ {
+		email := fmt.Sprintf("%s%d@example.com", prefix, i)
+		subscriber, _, err := client.Subscribers.Create(context.Background(), pageID, &statuspage.SubscriberInput{
+			Email:                        email,
+			SkipConfirmationNotification: statuspage.NewNullable(true),
+		})
+		if err != nil {
+			t.Fatalf("Subscribers.Create(%q): %v", email, err)
+		}
+		ids = append(ids, subscriber.ID)
+	}
+	return ids
+}
+
+func subscriberIDs(subscribers []*statuspage.Subscriber) []string {
+	ids := []string{}
+	for _, subscriber := range subscribers {
+		ids = append(ids, subscriber.ID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestSubscribersListAllAcrossPages(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ctx := context.Background()
+	active := createSubscribers(t, client, pageID, "active", 5)
+	unsubscribed := createSubscribers(t, client, pageID, "gone", 5)
+	if _, err := client.Subscribers.Unsubscribe(ctx, pageID, &statuspage.SubscriberBulkInput{SubscriberIDs: unsubscribed}); err != nil {
+		t.Fatalf("Subscribers.Unsubscribe: %v", err)
+	}
+
+	first, _, err := client.Subscribers.List(ctx, pageID, &statuspage.SubscriberListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Subscribers.List: %v", err)
+	}
+	if len(first) != 2 {
+		t.Errorf("Subscribers.List with limit 2 returned %d subscribers, want 2", len(first))
+	}
+	all, _, err := client.Subscribers.ListAll(ctx, pageID, &statuspage.SubscriberListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Subscribers.ListAll: %v", err)
+	}
+	if got := subscriberIDs(all); fmt.Sprint(got) != fmt.Sprint(active) {
+		t.Errorf("Subscribers.ListAll = %v, want %v", got, active)
+	}
+
+	first, _, err = client.Subscribers.ListUnsubscribed(ctx, pageID, &statuspage.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("Subscribers.ListUnsubscribed: %v", err)
+	}
+	if len(first) != 2 {
+		t.Errorf("Subscribers.ListUnsubscribed with 2 per page returned %d subscribers, want 2", len(first))
+	}
+	all, _, err = client.Subscribers.ListAllUnsubscribed(ctx, pageID, &statuspage.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("Subscribers.ListAllUnsubscribed: %v", err)
+	}
+	if got := subscriberIDs(all); fmt.Sprint(got) != fmt.Sprint(unsubscribed) {
+		t.Errorf("Subscribers.ListAllUnsubscribed = %v, want %v", got, unsubscribed)
+	}
+}
+
+func TestSubscribersResendConfirmations(t *testing.T) {
+	_, client, pageID := newFakeClient(t)
+	ctx := context.Background()
+	subscriber, _, err := client.Subscribers.Create(ctx, pageID, &statuspage.SubscriberInput{Email: "pending@example.com"})
+	if err != nil {
+		t.Fatalf("Subscribers.Create: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		input   *statuspage.SubscriberResendInput
+		wantErr bool
+	}{
+		{"by ID", &statuspage.SubscriberResendInput{SubscriberIDs: []string{subscriber.ID}}, false},
+		{"all", &statuspage.SubscriberResendInput{All: true}, false},
+		{"nothing selected", &statuspage.SubscriberResendInput{}, true},
+		{"nil", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Subscribers.ResendConfirmations(ctx, pageID, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResendConfirmations() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package statuspage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// uptimeDateLayout is the full date format accepted by the uptime endpoints
+const uptimeDateLayout = "2006-01-02"
+
+// maxUptimeDays bounds per-day breakdowns to the six calendar months the API supports
+const maxUptimeDays = 186
+
+// UptimeOptions selects the date range for uptime queries. Start and End accept a full date (2006-01-02),
+// a year and month (2006-01) or a year (2006); the API expands partial dates to the first day of the period
+// for Start and the last day for End.
+type UptimeOptions struct {
+	Start             string `url:"start,omitempty"`
+	End               string `url:"end,omitempty"`
+	SkipRelatedEvents bool   `url:"skip_related_events,omitempty"`
+}
+
+// ParseUptimeDate parses a full or partial uptime date and returns the first and last day it covers
+func ParseUptimeDate(value string) (first, last time.Time, err error) {
+	if t, err := time.Parse(uptimeDateLayout, value); err == nil {
+		return t, t, nil
+	}
+	if t, err := time.Parse("2006-01", value); err == nil {
+		return t, t.AddDate(0, 1, -1), nil
+	}
+	if t, err := time.Parse("2006", value); err == nil {
+		return t, t.AddDate(1, 0, -1), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid uptime date %q: expected YYYY, YYYY-MM or YYYY-MM-DD", value)
+}
+
+// uptimeDays expands the options range into one options value per calendar day
+func uptimeDays(opts *UptimeOptions) ([]*UptimeOptions, error) {
+	if opts == nil || opts.Start == "" || opts.End == "" {
+		return nil, errors.New("uptime breakdown requires both a start and an end date")
+	}
+
+	first, _, err := ParseUptimeDate(opts.Start)
+	if err != nil {
+		return nil, err
+	}
+	_, last, err := ParseUptimeDate(opts.End)
+	if err != nil {
+		return nil, err
+	}
+	if last.Before(first) {
+		return nil, fmt.Errorf("uptime end date %s is before start date %s", opts.End, opts.Start)
+	}
+
+	var days []*UptimeOptions
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if len(days) == maxUptimeDays {
+			return nil, fmt.Errorf("uptime range exceeds %d days", maxUptimeDays)
+		}
+		date := day.Format(uptimeDateLayout)
+		days = append(days, &UptimeOptions{
+			Start:             date,
+			End:               date,
+			SkipRelatedEvents: opts.SkipRelatedEvents,
+		})
+	}
+	return days, nil
+}
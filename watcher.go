@@ -0,0 +1,275 @@
+package statuspage
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// DefaultWatchInterval is how often a Watcher polls each status page when no interval is configured
+const DefaultWatchInterval = 30 * time.Second
+
+// WatchEventType identifies the kind of change a Watcher observed
+type WatchEventType string
+
+// Watch event types
+const (
+	WatchComponentStatusChanged WatchEventType = "component_status_changed"
+	WatchIncidentOpened         WatchEventType = "incident_opened"
+	WatchIncidentUpdated        WatchEventType = "incident_updated"
+	WatchIncidentResolved       WatchEventType = "incident_resolved"
+	WatchPollFailed             WatchEventType = "poll_failed"
+)
+
+// WatchEvent describes one change on a watched status page. Component is set for component events,
+// Incident for incident events and Err for failed polls.
+type WatchEvent struct {
+	Type    WatchEventType
+	PageURL string
+	Page    PublicPage
+	At      time.Time
+
+	Component      *Component
+	PreviousStatus string
+
+	Incident *Incident
+
+	Err error
+}
+
+// WatcherOption is a functional option for configuring a Watcher
+type WatcherOption func(*Watcher)
+
+// WithWatchInterval sets how often each status page is polled
+func WithWatchInterval(interval time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		if interval > 0 {
+			w.interval = interval
+		}
+	}
+}
+
+// WithWatchClientOptions configures the public clients used to poll the status pages
+func WithWatchClientOptions(opts ...ClientOption) WatcherOption {
+	return func(w *Watcher) {
+		w.clientOpts = append(w.clientOpts, opts...)
+	}
+}
+
+// WithWatchBuffer sets the capacity of the events channel. Polling pauses while the channel is full.
+func WithWatchBuffer(size int) WatcherOption {
+	return func(w *Watcher) {
+		if size >= 0 {
+			w.buffer = size
+		}
+	}
+}
+
+// WithWatchReportExisting makes the first poll report incidents that are already open as opened. By default
+// the first poll only records a baseline.
+func WithWatchReportExisting() WatcherOption {
+	return func(w *Watcher) {
+		w.reportExisting = true
+	}
+}
+
+// Watcher polls the public status API of third-party status pages and reports component status changes and
+// incident activity on a channel
+type Watcher struct {
+	pageURLs       []string
+	interval       time.Duration
+	clientOpts     []ClientOption
+	buffer         int
+	reportExisting bool
+
+	clients   map[string]*PublicClient
+	snapshots map[string]*watchSnapshot
+	events    chan WatchEvent
+}
+
+// watchSnapshot is the state of a status page as of the previous poll
+type watchSnapshot struct {
+	components map[string]*Component
+	incidents  map[string]*Incident
+}
+
+// NewWatcher creates a watcher for the status pages hosted at pageURLs, such as "https://www.githubstatus.com".
+// Polling starts when Run is called.
+func NewWatcher(pageURLs []string, opts ...WatcherOption) *Watcher {
+	w := &Watcher{
+		pageURLs:  append([]string(nil), pageURLs...),
+		interval:  DefaultWatchInterval,
+		buffer:    64,
+		clients:   map[string]*PublicClient{},
+		snapshots: map[string]*watchSnapshot{},
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	for _, pageURL := range w.pageURLs {
+		w.clients[pageURL] = NewPublicClient(pageURL, w.clientOpts...)
+	}
+	w.events = make(chan WatchEvent, w.buffer)
+	return w
+}
+
+// Events returns the channel on which changes are reported. It is closed when Run returns.
+func (w *Watcher) Events() <-chan WatchEvent {
+	return w.events
+}
+
+// Run polls every status page immediately and then once per interval until ctx is done. It must be called
+// at most once.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.events)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, pageURL := range w.pageURLs {
+			if err := w.poll(ctx, pageURL); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches the current state of one page, emits the differences from the previous snapshot and stores
+// the new snapshot. It only returns an error when ctx is done.
+func (w *Watcher) poll(ctx context.Context, pageURL string) error {
+	client := w.clients[pageURL]
+	summary, _, err := client.Summary(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return w.emit(ctx, WatchEvent{Type: WatchPollFailed, PageURL: pageURL, At: time.Now(), Err: err})
+	}
+
+	current := &watchSnapshot{
+		components: map[string]*Component{},
+		incidents:  map[string]*Incident{},
+	}
+	for _, component := range summary.Components {
+		current.components[component.ID] = component
+	}
+	for _, incident := range summary.Incidents {
+		current.incidents[incident.ID] = incident
+	}
+
+	previous, seen := w.snapshots[pageURL]
+	w.snapshots[pageURL] = current
+	if !seen && !w.reportExisting {
+		return nil
+	}
+	if !seen {
+		previous = &watchSnapshot{}
+	}
+
+	base := WatchEvent{PageURL: pageURL, Page: summary.Page, At: time.Now()}
+	for _, event := range w.diff(ctx, client, previous, current, summary, base) {
+		if err := w.emit(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diff returns the events that lead from the previous snapshot to the current one, in the order the
+// components and incidents appear in the summary
+func (w *Watcher) diff(ctx context.Context, client *PublicClient, previous, current *watchSnapshot, summary *PublicSummary, base WatchEvent) []WatchEvent {
+	var events []WatchEvent
+
+	for _, component := range summary.Components {
+		old, ok := previous.components[component.ID]
+		if !ok || old.Status == component.Status {
+			continue
+		}
+		event := base
+		event.Type = WatchComponentStatusChanged
+		event.Component = component
+		event.PreviousStatus = old.Status
+		events = append(events, event)
+	}
+
+	for _, incident := range summary.Incidents {
+		event := base
+		event.Incident = incident
+
+		old, ok := previous.incidents[incident.ID]
+		switch {
+		case !ok:
+			event.Type = WatchIncidentOpened
+		case isResolvedStatus(incident.Status) && !isResolvedStatus(old.Status):
+			event.Type = WatchIncidentResolved
+		case incidentChanged(old, incident):
+			event.Type = WatchIncidentUpdated
+		default:
+			continue
+		}
+		events = append(events, event)
+	}
+
+	var gone []*Incident
+	for id, incident := range previous.incidents {
+		if _, ok := current.incidents[id]; !ok && !isResolvedStatus(incident.Status) {
+			gone = append(gone, incident)
+		}
+	}
+	if len(gone) == 0 {
+		return events
+	}
+	sort.Slice(gone, func(i, j int) bool {
+		return gone[i].ID < gone[j].ID
+	})
+
+	// Resolved incidents drop out of the summary; look up their final state when possible
+	recent := map[string]*Incident{}
+	if incidents, _, err := client.Incidents(ctx); err == nil {
+		for _, incident := range incidents {
+			recent[incident.ID] = incident
+		}
+	}
+	for _, incident := range gone {
+		if final, ok := recent[incident.ID]; ok {
+			incident = final
+		}
+		event := base
+		event.Type = WatchIncidentResolved
+		event.Incident = incident
+		events = append(events, event)
+	}
+	return events
+}
+
+// emit sends an event, waiting for room on the channel unless ctx is done first
+func (w *Watcher) emit(ctx context.Context, event WatchEvent) error {
+	select {
+	case w.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isResolvedStatus reports whether an incident status means the incident is over
+func isResolvedStatus(status string) bool {
+	return status == IncidentStatusResolved || status == IncidentStatusCompleted || status == "postmortem"
+}
+
+// incidentChanged reports whether an incident received an update or changed status or impact
+func incidentChanged(old, incident *Incident) bool {
+	return old.Status != incident.Status ||
+		old.Impact != incident.Impact ||
+		len(old.IncidentUpdates) != len(incident.IncidentUpdates) ||
+		!old.UpdatedAt.Equal(incident.UpdatedAt.Time)
+}
@@ -0,0 +1,185 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxWebhookBody bounds the size of a webhook notification read by WebhookHandler
+const maxWebhookBody = 1 << 20
+
+// ErrUnknownWebhook is returned by ParseWebhook for payloads that are neither component nor incident updates
+var ErrUnknownWebhook = errors.New("statuspage: unrecognized webhook payload")
+
+// WebhookMeta carries the links included in every webhook notification
+type WebhookMeta struct {
+	Unsubscribe   string `json:"unsubscribe,omitempty"`
+	Documentation string `json:"documentation,omitempty"`
+}
+
+// WebhookPage identifies the page that sent a webhook notification and its overall status at the time
+type WebhookPage struct {
+	ID                string `json:"id,omitempty"`
+	StatusIndicator   string `json:"status_indicator,omitempty"`
+	StatusDescription string `json:"status_description,omitempty"`
+}
+
+// ComponentUpdate records a single component status transition
+type ComponentUpdate struct {
+	ID          string `json:"id,omitempty"`
+	ComponentID string `json:"component_id,omitempty"`
+	OldStatus   string `json:"old_status,omitempty"`
+	NewStatus   string `json:"new_status,omitempty"`
+	CreatedAt   Time   `json:"created_at,omitempty"`
+}
+
+// ComponentUpdateEvent is the notification sent to webhook subscribers when a component changes status
+type ComponentUpdateEvent struct {
+	Meta            WebhookMeta     `json:"meta"`
+	Page            WebhookPage     `json:"page"`
+	ComponentUpdate ComponentUpdate `json:"component_update"`
+	Component       Component       `json:"component"`
+}
+
+// IncidentUpdateEvent is the notification sent to webhook subscribers when an incident is created or updated.
+// Incident includes every incident update, newest first.
+type IncidentUpdateEvent struct {
+	Meta     WebhookMeta `json:"meta"`
+	Page     WebhookPage `json:"page"`
+	Incident Incident    `json:"incident"`
+}
+
+// LatestUpdate returns the incident update that triggered the notification, or nil if none is included
+func (e *IncidentUpdateEvent) LatestUpdate() *IncidentUpdate {
+	if len(e.Incident.IncidentUpdates) == 0 {
+		return nil
+	}
+	return &e.Incident.IncidentUpdates[0]
+}
+
+// webhookPayload holds the top-level keys used to tell the notification types apart
+type webhookPayload struct {
+	ComponentUpdate json.RawMessage `json:"component_update"`
+	Incident        json.RawMessage `json:"incident"`
+}
+
+// ParseWebhook decodes a webhook notification body into a *ComponentUpdateEvent or an *IncidentUpdateEvent
+func ParseWebhook(body []byte) (interface{}, error) {
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case webhookKeyPresent(payload.ComponentUpdate):
+		event := new(ComponentUpdateEvent)
+		if err := json.Unmarshal(body, event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	case webhookKeyPresent(payload.Incident):
+		event := new(IncidentUpdateEvent)
+		if err := json.Unmarshal(body, event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	default:
+		return nil, ErrUnknownWebhook
+	}
+}
+
+// webhookKeyPresent reports whether a top-level key was sent with a non-null value
+func webhookKeyPresent(raw json.RawMessage) bool {
+	return len(raw) > 0 && string(raw) != "null"
+}
+
+// WebhookHandler is an http.Handler for the endpoint of a webhook subscriber. It decodes each notification and
+// passes it to the callbacks registered for its type. Callbacks run on the request goroutine; if one returns an
+// error the handler responds with 500 so that the delivery counts as failed.
+//
+// Statuspage does not sign webhook notifications, so the endpoint URL should contain a secret that is checked
+// before requests reach the handler.
+type WebhookHandler struct {
+	mu                sync.RWMutex
+	componentHandlers []func(context.Context, *ComponentUpdateEvent) error
+	incidentHandlers  []func(context.Context, *IncidentUpdateEvent) error
+}
+
+// NewWebhookHandler creates a webhook handler with no callbacks registered
+func NewWebhookHandler() *WebhookHandler {
+	return &WebhookHandler{}
+}
+
+// OnComponentUpdate registers fn to be called for every component update notification
+func (h *WebhookHandler) OnComponentUpdate(fn func(ctx context.Context, event *ComponentUpdateEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.componentHandlers = append(h.componentHandlers, fn)
+}
+
+// OnIncidentUpdate registers fn to be called for every incident update notification
+func (h *WebhookHandler) OnIncidentUpdate(fn func(ctx context.Context, event *IncidentUpdateEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.incidentHandlers = append(h.incidentHandlers, fn)
+}
+
+// ServeHTTP decodes a POSTed notification and dispatches it. Malformed bodies are rejected with 400 and
+// notifications of unknown types are acknowledged without dispatching.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := ParseWebhook(body)
+	if errors.Is(err, ErrUnknownWebhook) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// dispatch calls the callbacks registered for the event type in registration order, stopping at the first error
+func (h *WebhookHandler) dispatch(ctx context.Context, event interface{}) error {
+	h.mu.RLock()
+	componentHandlers := h.componentHandlers
+	incidentHandlers := h.incidentHandlers
+	h.mu.RUnlock()
+
+	switch e := event.(type) {
+	case *ComponentUpdateEvent:
+		for _, fn := range componentHandlers {
+			if err := fn(ctx, e); err != nil {
+				return err
+			}
+		}
+	case *IncidentUpdateEvent:
+		for _, fn := range incidentHandlers {
+			if err := fn(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package statuspage_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	statuspage "github.com/MinseokOh/statuspage-sdk-go"
+	"github.com/MinseokOh/statuspage-sdk-go/statuspagetest"
+)
+
+func TestWebhookHandlerComponentUpdate(t *testing.T) {
+	h := statuspage.NewWebhookHandler()
+	var got *statuspage.ComponentUpdateEvent
+	h.OnComponentUpdate(func(ctx context.Context, event *statuspage.ComponentUpdateEvent) error {
+		got = event
+		return nil
+	})
+	h.OnIncidentUpdate(func(ctx context.Context, event *statuspage.IncidentUpdateEvent) error {
+		t.Error("incident callback called for a component update")
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, statuspagetest.NewWebhookRequest(statuspagetest.ComponentUpdateWebhook))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if got == nil {
+		t.Fatal("component callback not called")
+	}
+	if got.Page.ID != "j2mfxwj97wnj" {
+		t.Errorf("Page.ID = %q, want %q", got.Page.ID, "j2mfxwj97wnj")
+	}
+	if got.ComponentUpdate.OldStatus != statuspage.ComponentStatusMajorOutage {
+		t.Errorf("OldStatus = %q, want %q", got.ComponentUpdate.OldStatus, statuspage.ComponentStatusMajorOutage)
+	}
+	if got.ComponentUpdate.NewStatus != statuspage.ComponentStatusOperational {
+		t.Errorf("NewStatus = %q, want %q", got.ComponentUpdate.NewStatus, statuspage.ComponentStatusOperational)
+	}
+	if got.ComponentUpdate.ComponentID != "rb5wq1dczvbm" || got.Component.ID != "rb5wq1dczvbm" {
+		t.Errorf("component IDs = %q, %q, want %q", got.ComponentUpdate.ComponentID, got.Component.ID, "rb5wq1dczvbm")
+	}
+	if got.Component.Name != "Some Component" {
+		t.Errorf("Component.Name = %q, want %q", got.Component.Name, "Some Component")
+	}
+}
+
+func TestWebhookHandlerIncidentUpdate(t *testing.T) {
+	h := statuspage.NewWebhookHandler()
+	var got *statuspage.IncidentUpdateEvent
+	h.OnIncidentUpdate(func(ctx context.Context, event *statuspage.IncidentUpdateEvent) error {
+		got = event
+		return nil
+	})
+	h.OnComponentUpdate(func(ctx context.Context, event *statuspage.ComponentUpdateEvent) error {
+		t.Error("component callback called for an incident update")
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, statuspagetest.NewWebhookRequest(statuspagetest.IncidentUpdateWebhook))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if got == nil {
+		t.Fatal("incident callback not called")
+	}
+	if got.Incident.Name != "Virginia Is Down" {
+		t.Errorf("Incident.Name = %q, want %q", got.Incident.Name, "Virginia Is Down")
+	}
+	if got.Incident.Status != statuspage.IncidentStatusMonitoring {
+		t.Errorf("Incident.Status = %q, want %q", got.Incident.Status, statuspage.IncidentStatusMonitoring)
+	}
+
+	wantOrder := []string{
+		statuspage.IncidentStatusMonitoring,
+		statuspage.IncidentStatusIdentified,
+		statuspage.IncidentStatusInvestigating,
+	}
+	if len(got.Incident.IncidentUpdates) != len(wantOrder) {
+		t.Fatalf("len(IncidentUpdates) = %d, want %d", len(got.Incident.IncidentUpdates), len(wantOrder))
+	}
+	for i, status := range wantOrder {
+		if got.Incident.IncidentUpdates[i].Status != status {
+			t.Errorf("IncidentUpdates[%d].Status = %q, want %q", i, got.Incident.IncidentUpdates[i].Status, status)
+		}
+	}
+
+	latest := got.LatestUpdate()
+	if latest == nil {
+		t.Fatal("LatestUpdate() = nil")
+	}
+	if latest.ID != "drfcwbnpxnr6" {
+		t.Errorf("LatestUpdate().ID = %q, want %q", latest.ID, "drfcwbnpxnr6")
+	}
+	if latest.Body != "A fix has been implemented and we are monitoring the results." {
+		t.Errorf("LatestUpdate().Body = %q", latest.Body)
+	}
+}
+
+func TestWebhookHandlerErrors(t *testing.T) {
+	failing := statuspage.NewWebhookHandler()
+	failing.OnComponentUpdate(func(ctx context.Context, event *statuspage.ComponentUpdateEvent) error {
+		return errors.New("callback failed")
+	})
+
+	unknown := statuspagetest.NewWebhookRequest(`{"meta": {}, "page": {"id": "j2mfxwj97wnj"}}`)
+	get := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+
+	tests := []struct {
+		name    string
+		handler *statuspage.WebhookHandler
+		req     *http.Request
+		want    int
+	}{
+		{"malformed body", statuspage.NewWebhookHandler(), statuspagetest.NewWebhookRequest(`{"component_update": `), http.StatusBadRequest},
+		{"unknown event", statuspage.NewWebhookHandler(), unknown, http.StatusNoContent},
+		{"non-POST", statuspage.NewWebhookHandler(), get, http.StatusMethodNotAllowed},
+		{"handler error", failing, statuspagetest.NewWebhookRequest(statuspagetest.ComponentUpdateWebhook), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, tt.req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if tt.want == http.StatusMethodNotAllowed && rec.Header().Get("Allow") != http.MethodPost {
+				t.Errorf("Allow = %q, want %q", rec.Header().Get("Allow"), http.MethodPost)
+			}
+		})
+	}
+}